@@ -0,0 +1,92 @@
+package bridgeErrors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// messages returned by the proxy and the nodes, matched in lower case
+var (
+	insufficientFundsMessages = []string{"insufficient funds", "insufficient balance"}
+	nonceMessages             = []string{"lower nonce", "higher nonce", "nonce is lower", "nonce is higher", "nonce is too", "nonce too"}
+	scRejectedMessages        = []string{"user error", "execution failed", "contract not found", "function not found", "invalid contract"}
+	unavailableMessages       = []string{"connection refused", "service unavailable", "bad gateway", "gateway timeout", "too many requests"}
+)
+
+// Classify wraps the provided error into the matching typed error. Errors which are already typed, context errors and
+// errors which can not be classified are returned unchanged.
+func Classify(err error) error {
+	if err == nil || hasStatus(err) || isContextError(err) {
+		return err
+	}
+
+	message := strings.ToLower(err.Error())
+	switch {
+	case containsAny(message, insufficientFundsMessages):
+		return &InsufficientFundsError{Err: err}
+	case containsAny(message, nonceMessages):
+		return &NonceError{Err: err}
+	case containsAny(message, scRejectedMessages):
+		return &SCRejectedError{Err: err}
+	case isUnavailable(err, message):
+		return &UnavailableError{Err: err}
+	default:
+		return err
+	}
+}
+
+// ToGRPCError converts the provided error into a grpc status error. Typed errors keep their code and details, context
+// errors are mapped to canceled or deadline exceeded and any other error is reported as internal.
+func ToGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	err = Classify(err)
+	if hasStatus(err) {
+		st, _ := status.FromError(err)
+		return st.Err()
+	}
+	if isContextError(err) {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func hasStatus(err error) bool {
+	var withStatus interface {
+		GRPCStatus() *status.Status
+	}
+
+	return errors.As(err, &withStatus)
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func isUnavailable(err error, message string) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, blockchain.ErrHTTPStatusCodeIsNotOK) || containsAny(message, unavailableMessages)
+}
+
+func containsAny(message string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(message, substring) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package bridgeErrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil error", func(t *testing.T) {
+		require.Nil(t, Classify(nil))
+	})
+	t.Run("typed and context errors should not change", func(t *testing.T) {
+		validationErr := &ValidationError{Field: "data", Description: "nil"}
+		require.Equal(t, validationErr, Classify(validationErr))
+
+		wrappedErr := fmt.Errorf("wrapped: %w", validationErr)
+		require.Equal(t, wrappedErr, Classify(wrappedErr))
+
+		require.Equal(t, context.Canceled, Classify(context.Canceled))
+		require.Equal(t, context.DeadlineExceeded, Classify(context.DeadlineExceeded))
+	})
+	t.Run("insufficient funds", func(t *testing.T) {
+		err := errors.New("insufficient funds for address drt1")
+		require.Equal(t, &InsufficientFundsError{Err: err}, Classify(err))
+
+		err = errors.New("Insufficient balance for fees")
+		require.Equal(t, &InsufficientFundsError{Err: err}, Classify(err))
+	})
+	t.Run("nonce", func(t *testing.T) {
+		err := errors.New("lower nonce in transaction")
+		require.Equal(t, &NonceError{Err: err}, Classify(err))
+
+		err = errors.New("transaction nonce is too high")
+		require.Equal(t, &NonceError{Err: err}, Classify(err))
+	})
+	t.Run("sc rejected", func(t *testing.T) {
+		err := errors.New("user error: invalid signature")
+		require.Equal(t, &SCRejectedError{Err: err}, Classify(err))
+	})
+	t.Run("unavailable", func(t *testing.T) {
+		err := fmt.Errorf("%w, returned http status: 502, Bad Gateway", blockchain.ErrHTTPStatusCodeIsNotOK)
+		require.Equal(t, &UnavailableError{Err: err}, Classify(err))
+
+		err = fmt.Errorf("post failed: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+		require.Equal(t, &UnavailableError{Err: err}, Classify(err))
+
+		err = errors.New("503 service unavailable")
+		require.Equal(t, &UnavailableError{Err: err}, Classify(err))
+	})
+	t.Run("unknown error should not change", func(t *testing.T) {
+		err := errors.New("local error")
+		require.Equal(t, err, Classify(err))
+	})
+}
+
+func TestToGRPCError(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, ToGRPCError(nil))
+
+	require.Equal(t, codes.InvalidArgument, status.Code(ToGRPCError(&ValidationError{Field: "data", Description: "nil"})))
+	require.Equal(t, codes.FailedPrecondition, status.Code(ToGRPCError(errors.New("insufficient funds"))))
+	require.Equal(t, codes.Aborted, status.Code(ToGRPCError(errors.New("higher nonce in transaction"))))
+	require.Equal(t, codes.FailedPrecondition, status.Code(ToGRPCError(errors.New("execution failed"))))
+	require.Equal(t, codes.Unavailable, status.Code(ToGRPCError(blockchain.ErrHTTPStatusCodeIsNotOK)))
+	require.Equal(t, codes.Canceled, status.Code(ToGRPCError(context.Canceled)))
+	require.Equal(t, codes.DeadlineExceeded, status.Code(ToGRPCError(fmt.Errorf("send: %w", context.DeadlineExceeded))))
+	require.Equal(t, codes.Internal, status.Code(ToGRPCError(errors.New("local error"))))
+
+	err := ToGRPCError(&UnavailableError{Err: errors.New("proxy down")})
+	require.Equal(t, "rpc error: code = Unavailable desc = unavailable: proxy down", err.Error())
+
+	grpcErr := status.Error(codes.NotFound, "not found")
+	require.Equal(t, grpcErr, ToGRPCError(grpcErr))
+}
@@ -0,0 +1,61 @@
+package bridgeErrors
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsRetryable returns true if the call failed with a transient grpc error, or if the server attached retry info to the
+// error, so the call might succeed if retried
+func IsRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	default:
+		_, hasRetryInfo := RetryDelay(err)
+		return hasRetryInfo
+	}
+}
+
+// RetryDelay returns the delay suggested by the server before retrying the failed call, if the error holds retry info
+func RetryDelay(err error) (time.Duration, bool) {
+	retryInfo, found := findDetail[*errdetails.RetryInfo](err)
+	if !found || retryInfo.GetRetryDelay() == nil {
+		return 0, false
+	}
+
+	return retryInfo.GetRetryDelay().AsDuration(), true
+}
+
+// Reason returns the error info reason, such as ReasonNonce, or an empty string if the error holds no error info
+func Reason(err error) string {
+	errorInfo, _ := findDetail[*errdetails.ErrorInfo](err)
+	return errorInfo.GetReason()
+}
+
+// FieldViolations returns the bad request field violations, if any
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	badRequest, _ := findDetail[*errdetails.BadRequest](err)
+	return badRequest.GetFieldViolations()
+}
+
+func findDetail[T any](err error) (T, bool) {
+	var detail T
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return detail, false
+	}
+
+	for _, d := range st.Details() {
+		detail, ok = d.(T)
+		if ok {
+			return detail, true
+		}
+	}
+
+	return detail, false
+}
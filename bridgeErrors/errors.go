@@ -0,0 +1,174 @@
+package bridgeErrors
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	errorDomain = "sovereign-bridge"
+
+	// ReasonValidation is the error info reason of ValidationError
+	ReasonValidation = "VALIDATION"
+	// ReasonInsufficientFunds is the error info reason of InsufficientFundsError
+	ReasonInsufficientFunds = "INSUFFICIENT_FUNDS"
+	// ReasonUnavailable is the error info reason of UnavailableError
+	ReasonUnavailable = "UNAVAILABLE"
+	// ReasonNonce is the error info reason of NonceError
+	ReasonNonce = "NONCE"
+	// ReasonSCRejected is the error info reason of SCRejectedError
+	ReasonSCRejected = "SC_REJECTED"
+
+	defaultUnavailableRetryDelay = time.Second
+	defaultNonceRetryDelay       = time.Millisecond * 100
+)
+
+// ValidationError signals that the received bridge operations are invalid. Sending the same request again will fail.
+type ValidationError struct {
+	Field       string
+	Description string
+}
+
+// Error returns the error message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Description)
+}
+
+// GRPCStatus returns the invalid argument status, with the bad request details
+func (e *ValidationError) GRPCStatus() *status.Status {
+	return newStatus(codes.InvalidArgument, e, ReasonValidation,
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       e.Field,
+					Description: e.Description,
+				},
+			},
+		},
+	)
+}
+
+// InsufficientFundsError signals that the bridge wallet can not pay for the txs
+type InsufficientFundsError struct {
+	Err error
+}
+
+// Error returns the error message
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient funds: %v", e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *InsufficientFundsError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the failed precondition status, with the precondition failure details
+func (e *InsufficientFundsError) GRPCStatus() *status.Status {
+	return newStatus(codes.FailedPrecondition, e, ReasonInsufficientFunds,
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        ReasonInsufficientFunds,
+					Subject:     "wallet",
+					Description: e.Err.Error(),
+				},
+			},
+		},
+	)
+}
+
+// UnavailableError signals that the proxy could not be reached or could not handle the txs, or that the server is
+// closing. The request can be retried after RetryDelay.
+type UnavailableError struct {
+	Err        error
+	RetryDelay time.Duration
+}
+
+// Error returns the error message
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("unavailable: %v", e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the unavailable status, with the retry info details
+func (e *UnavailableError) GRPCStatus() *status.Status {
+	return newStatus(codes.Unavailable, e, ReasonUnavailable, newRetryInfo(e.RetryDelay, defaultUnavailableRetryDelay))
+}
+
+// NonceError signals that the txs were rejected because their nonces did not match the account nonce. The nonce is
+// fetched again for the next txs, so the request can be retried after RetryDelay.
+type NonceError struct {
+	Err        error
+	RetryDelay time.Duration
+}
+
+// Error returns the error message
+func (e *NonceError) Error() string {
+	return fmt.Sprintf("nonce mismatch: %v", e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *NonceError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the aborted status, with the retry info details
+func (e *NonceError) GRPCStatus() *status.Status {
+	return newStatus(codes.Aborted, e, ReasonNonce, newRetryInfo(e.RetryDelay, defaultNonceRetryDelay))
+}
+
+// SCRejectedError signals that the txs were rejected by the header verifier or the dcdt safe contracts
+type SCRejectedError struct {
+	Err error
+}
+
+// Error returns the error message
+func (e *SCRejectedError) Error() string {
+	return fmt.Sprintf("rejected by smart contract: %v", e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *SCRejectedError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the failed precondition status
+func (e *SCRejectedError) GRPCStatus() *status.Status {
+	return newStatus(codes.FailedPrecondition, e, ReasonSCRejected)
+}
+
+func newRetryInfo(delay time.Duration, defaultDelay time.Duration) *errdetails.RetryInfo {
+	if delay <= 0 {
+		delay = defaultDelay
+	}
+
+	return &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(delay),
+	}
+}
+
+func newStatus(code codes.Code, err error, reason string, details ...protoiface.MessageV1) *status.Status {
+	st := status.New(code, err.Error())
+	details = append([]protoiface.MessageV1{&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	}}, details...)
+
+	stWithDetails, errDetails := st.WithDetails(details...)
+	if errDetails != nil {
+		return st
+	}
+
+	return stWithDetails
+}
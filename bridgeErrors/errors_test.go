@@ -0,0 +1,77 @@
+package bridgeErrors
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTypedErrors(t *testing.T) {
+	t.Parallel()
+
+	causeErr := errors.New("cause")
+
+	t.Run("validation error", func(t *testing.T) {
+		err := ToGRPCError(&ValidationError{Field: "data[0].hash", Description: "empty hash"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, ReasonValidation, Reason(err))
+
+		violations := FieldViolations(err)
+		require.Len(t, violations, 1)
+		require.Equal(t, "data[0].hash", violations[0].GetField())
+		require.Equal(t, "empty hash", violations[0].GetDescription())
+
+		_, hasRetryInfo := RetryDelay(err)
+		require.False(t, hasRetryInfo)
+	})
+	t.Run("insufficient funds error", func(t *testing.T) {
+		typedErr := &InsufficientFundsError{Err: causeErr}
+		require.ErrorIs(t, typedErr, causeErr)
+
+		err := ToGRPCError(typedErr)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Equal(t, ReasonInsufficientFunds, Reason(err))
+	})
+	t.Run("unavailable error should have default retry delay", func(t *testing.T) {
+		typedErr := &UnavailableError{Err: causeErr}
+		require.ErrorIs(t, typedErr, causeErr)
+
+		err := ToGRPCError(typedErr)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, ReasonUnavailable, Reason(err))
+
+		retryDelay, hasRetryInfo := RetryDelay(err)
+		require.True(t, hasRetryInfo)
+		require.Equal(t, defaultUnavailableRetryDelay, retryDelay)
+	})
+	t.Run("nonce error should have the provided retry delay", func(t *testing.T) {
+		typedErr := &NonceError{Err: causeErr, RetryDelay: time.Second * 3}
+		require.ErrorIs(t, typedErr, causeErr)
+
+		err := ToGRPCError(typedErr)
+		require.Equal(t, codes.Aborted, status.Code(err))
+		require.Equal(t, ReasonNonce, Reason(err))
+
+		retryDelay, hasRetryInfo := RetryDelay(err)
+		require.True(t, hasRetryInfo)
+		require.Equal(t, time.Second*3, retryDelay)
+	})
+	t.Run("sc rejected error", func(t *testing.T) {
+		typedErr := &SCRejectedError{Err: causeErr}
+		require.ErrorIs(t, typedErr, causeErr)
+
+		err := ToGRPCError(typedErr)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Equal(t, ReasonSCRejected, Reason(err))
+	})
+	t.Run("plain errors have no details", func(t *testing.T) {
+		err := ToGRPCError(causeErr)
+		require.Empty(t, Reason(err))
+		require.Empty(t, FieldViolations(err))
+		require.Empty(t, Reason(nil))
+	})
+}
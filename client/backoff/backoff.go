@@ -0,0 +1,88 @@
+package backoff
+
+import (
+	"math/rand"
+	"time"
+)
+
+const defaultMultiplier = 2
+
+// ArgsExponentialBackoff holds args to create a new exponential backoff
+type ArgsExponentialBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	// Jitter should be in [0, 1] interval and randomizes each delay by +/- Jitter * delay
+	Jitter float64
+}
+
+type exponentialBackoff struct {
+	initial time.Duration
+	max     time.Duration
+	jitter  float64
+	current time.Duration
+}
+
+// NewExponentialBackoff creates an exponential backoff which doubles the delay after each attempt, up to a maximum
+func NewExponentialBackoff(args ArgsExponentialBackoff) (*exponentialBackoff, error) {
+	if args.Initial <= 0 {
+		return nil, errInvalidInitialBackoff
+	}
+	if args.Max < args.Initial {
+		return nil, errInvalidMaxBackoff
+	}
+	if args.Jitter < 0 || args.Jitter > 1 {
+		return nil, errInvalidJitter
+	}
+
+	return &exponentialBackoff{
+		initial: args.Initial,
+		max:     args.Max,
+		jitter:  args.Jitter,
+	}, nil
+}
+
+// Next returns the delay to wait before the next attempt
+func (eb *exponentialBackoff) Next() time.Duration {
+	if eb.current == 0 {
+		eb.current = eb.initial
+	} else {
+		eb.current *= defaultMultiplier
+	}
+	if eb.current > eb.max {
+		eb.current = eb.max
+	}
+
+	return eb.applyJitter(eb.current)
+}
+
+func (eb *exponentialBackoff) applyJitter(delay time.Duration) time.Duration {
+	if eb.jitter == 0 {
+		return delay
+	}
+
+	delta := eb.jitter * float64(delay)
+	return time.Duration(float64(delay) - delta + rand.Float64()*2*delta)
+}
+
+// Reset should be called after a successful attempt
+func (eb *exponentialBackoff) Reset() {
+	eb.current = 0
+}
+
+// Wait blocks for the next backoff delay. It returns false if the provided done channel is closed before.
+func (eb *exponentialBackoff) Wait(done <-chan struct{}) bool {
+	timer := time.NewTimer(eb.Next())
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-done:
+		return false
+	}
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (eb *exponentialBackoff) IsInterfaceNil() bool {
+	return eb == nil
+}
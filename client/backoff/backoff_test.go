@@ -0,0 +1,118 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createArgs() ArgsExponentialBackoff {
+	return ArgsExponentialBackoff{
+		Initial: time.Millisecond * 10,
+		Max:     time.Millisecond * 50,
+	}
+}
+
+func TestNewExponentialBackoff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid initial backoff", func(t *testing.T) {
+		args := createArgs()
+		args.Initial = 0
+		eb, err := NewExponentialBackoff(args)
+		require.Equal(t, errInvalidInitialBackoff, err)
+		require.Nil(t, eb)
+	})
+	t.Run("max lower than initial backoff", func(t *testing.T) {
+		args := createArgs()
+		args.Max = args.Initial - 1
+		eb, err := NewExponentialBackoff(args)
+		require.Equal(t, errInvalidMaxBackoff, err)
+		require.Nil(t, eb)
+	})
+	t.Run("invalid jitter", func(t *testing.T) {
+		args := createArgs()
+		args.Jitter = -0.1
+		eb, err := NewExponentialBackoff(args)
+		require.Equal(t, errInvalidJitter, err)
+		require.Nil(t, eb)
+
+		args.Jitter = 1.1
+		eb, err = NewExponentialBackoff(args)
+		require.Equal(t, errInvalidJitter, err)
+		require.Nil(t, eb)
+	})
+	t.Run("should work", func(t *testing.T) {
+		eb, err := NewExponentialBackoff(createArgs())
+		require.Nil(t, err)
+		require.False(t, eb.IsInterfaceNil())
+	})
+}
+
+func TestExponentialBackoff_Next(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should double the delay up to the max", func(t *testing.T) {
+		eb, _ := NewExponentialBackoff(createArgs())
+
+		require.Equal(t, time.Millisecond*10, eb.Next())
+		require.Equal(t, time.Millisecond*20, eb.Next())
+		require.Equal(t, time.Millisecond*40, eb.Next())
+		require.Equal(t, time.Millisecond*50, eb.Next())
+		require.Equal(t, time.Millisecond*50, eb.Next())
+	})
+	t.Run("jitter should keep the delay within bounds", func(t *testing.T) {
+		args := createArgs()
+		args.Jitter = 0.2
+		eb, _ := NewExponentialBackoff(args)
+
+		expectedDelays := []time.Duration{
+			time.Millisecond * 10,
+			time.Millisecond * 20,
+			time.Millisecond * 40,
+			time.Millisecond * 50,
+		}
+		for i := 0; i < 100; i++ {
+			eb.Reset()
+			for _, expected := range expectedDelays {
+				delay := eb.Next()
+				require.GreaterOrEqual(t, delay, time.Duration(float64(expected)*0.8))
+				require.LessOrEqual(t, delay, time.Duration(float64(expected)*1.2))
+			}
+		}
+	})
+}
+
+func TestExponentialBackoff_Reset(t *testing.T) {
+	t.Parallel()
+
+	eb, _ := NewExponentialBackoff(createArgs())
+	_ = eb.Next()
+	_ = eb.Next()
+
+	eb.Reset()
+	require.Equal(t, time.Millisecond*10, eb.Next())
+}
+
+func TestExponentialBackoff_Wait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should wait for the delay", func(t *testing.T) {
+		eb, _ := NewExponentialBackoff(createArgs())
+
+		start := time.Now()
+		require.True(t, eb.Wait(make(chan struct{})))
+		require.GreaterOrEqual(t, time.Since(start), time.Millisecond*10)
+	})
+	t.Run("closed done channel should stop waiting", func(t *testing.T) {
+		args := createArgs()
+		args.Initial = time.Hour
+		args.Max = time.Hour
+		eb, _ := NewExponentialBackoff(args)
+
+		done := make(chan struct{})
+		close(done)
+		require.False(t, eb.Wait(done))
+	})
+}
@@ -0,0 +1,9 @@
+package backoff
+
+import "errors"
+
+var errInvalidInitialBackoff = errors.New("invalid initial backoff provided")
+
+var errInvalidMaxBackoff = errors.New("max backoff should not be lower than initial backoff")
+
+var errInvalidJitter = errors.New("invalid jitter provided, should be in [0, 1] interval")
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+)
+
+const bufConnTarget = "passthrough:///bufnet"
+
+// NewBufConnClient creates a client connected to an in-process server over the provided bufconn listener, without
+// tls. Calls go through the same retry interceptor as the production client. It is meant to be used in tests only.
+func NewBufConnClient(ctx context.Context, listener *bufconn.Listener) (*client, error) {
+	if listener == nil {
+		return nil, errNilBufConnListener
+	}
+
+	retryInterceptor, err := NewRetryInterceptor(config.RetryConfig{}, &marshal.GogoProtoMarshalizer{})
+	if err != nil {
+		return nil, err
+	}
+
+	connectionCfg := applyConnectionDefaults(config.ConnectionConfig{})
+	dialOpts := append(createDialOptions(connectionCfg, insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithChainUnaryInterceptor(retryInterceptor),
+	)
+
+	conn, err := connect(ctx, bufConnTarget, connectionCfg, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(sovereign.NewBridgeTxSenderClient(conn), conn)
+}
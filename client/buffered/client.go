@@ -0,0 +1,226 @@
+package buffered
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+)
+
+var log = logger.GetOrCreate("client/buffered")
+
+// ArgsBufferedClient holds args to create a new buffered client
+type ArgsBufferedClient struct {
+	Client     BridgeClient
+	Marshaller marshal.Marshalizer
+	Backoff    BackoffHandler
+	Path       string
+}
+
+type client struct {
+	bridgeClient BridgeClient
+	marshaller   marshal.Marshalizer
+	backoff      BackoffHandler
+	queue        *diskQueue
+	newEntry     chan struct{}
+	closing      chan struct{}
+	closeOnce    sync.Once
+	wg           sync.WaitGroup
+}
+
+// NewBufferedClient creates a client which persists bridge operations on disk before delivering them to the server.
+// Operations are delivered in order, in the background, and are removed from disk only after the server confirms them.
+// Operations left from a previous run are delivered first. Operations rejected with a non retryable error, as well as
+// corrupted operations which can not be decoded, are moved to the dead letter directory inside the buffer path, instead
+// of being retried.
+func NewBufferedClient(args ArgsBufferedClient) (*client, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := newDiskQueue(args.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		bridgeClient: args.Client,
+		marshaller:   args.Marshaller,
+		backoff:      args.Backoff,
+		queue:        queue,
+		newEntry:     make(chan struct{}, 1),
+		closing:      make(chan struct{}),
+	}
+
+	log.Info("starting buffered client", "path", args.Path, "pending bridge operations", queue.len())
+
+	c.wg.Add(1)
+	go c.deliverEntries()
+
+	return c, nil
+}
+
+func checkArgs(args ArgsBufferedClient) error {
+	if check.IfNil(args.Client) {
+		return errNilBridgeClient
+	}
+	if check.IfNil(args.Marshaller) {
+		return errNilMarshaller
+	}
+	if check.IfNil(args.Backoff) {
+		return errNilBackoff
+	}
+	if len(args.Path) == 0 {
+		return errEmptyBufferPath
+	}
+
+	return nil
+}
+
+// Send persists the bridge operations and returns an empty response. The operations are delivered asynchronously.
+func (c *client) Send(_ context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+	if data == nil {
+		return nil, errNilBridgeOperations
+	}
+
+	select {
+	case <-c.closing:
+		return nil, errClientClosed
+	default:
+	}
+
+	buff, err := c.marshaller.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.queue.push(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("buffered bridge operations", "id", id, "no. of bridge data", len(data.Data))
+	c.notifyNewEntry()
+
+	return &sovereign.BridgeOperationsResponse{}, nil
+}
+
+func (c *client) notifyNewEntry() {
+	select {
+	case c.newEntry <- struct{}{}:
+	default:
+	}
+}
+
+func (c *client) deliverEntries() {
+	defer c.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-c.closing
+		cancel()
+	}()
+
+	for {
+		entry, err := c.queue.peek()
+		if err != nil {
+			log.Error("could not read buffered bridge operations", "error", err)
+			if !c.backoff.Wait(c.closing) {
+				return
+			}
+			continue
+		}
+
+		if entry == nil {
+			select {
+			case <-c.newEntry:
+				continue
+			case <-c.closing:
+				return
+			}
+		}
+
+		err = c.deliver(ctx, entry)
+		if err != nil && (bridgeErrors.IsRetryable(err) || ctx.Err() != nil) {
+			log.Warn("could not deliver buffered bridge operations, retrying",
+				"id", entry.id,
+				"error", err,
+				"pending bridge operations", c.queue.len())
+			if !c.backoff.Wait(c.closing) {
+				return
+			}
+			continue
+		}
+
+		c.backoff.Reset()
+		if err != nil {
+			errMove := c.moveToDeadLetter(entry, err)
+			if errMove != nil && !c.backoff.Wait(c.closing) {
+				return
+			}
+			continue
+		}
+
+		err = c.queue.remove(entry.id)
+		log.LogIfError(err)
+	}
+}
+
+// deliver sends the entry to the server. Corrupted entries, which can never be delivered and would block the queue
+// forever, return a non retryable error, so that they are moved to the dead letter directory.
+func (c *client) deliver(ctx context.Context, entry *queueEntry) error {
+	data := &sovereign.BridgeOperations{}
+	err := c.marshaller.Unmarshal(data, entry.data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errCorruptedEntry, err)
+	}
+
+	sendCtx := idempotency.AppendToOutgoingContext(ctx, idempotency.KeyFromBytes(entry.data))
+	res, err := c.bridgeClient.Send(sendCtx, data)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("delivered buffered bridge operations", "id", entry.id, "no. of sent txs", len(res.GetTxHashes()))
+	return nil
+}
+
+// moveToDeadLetter sets aside the entry which can not be delivered, either corrupted or rejected by the server with a
+// non retryable error, such as invalid bridge operations, so that the entries behind it are still delivered
+func (c *client) moveToDeadLetter(entry *queueEntry, deliveryErr error) error {
+	err := c.queue.moveToDeadLetter(entry.id)
+	if err != nil {
+		log.Error("could not move undeliverable bridge operations to the dead letter directory",
+			"id", entry.id, "delivery error", deliveryErr, "error", err)
+		return err
+	}
+
+	log.Error("undeliverable bridge operations moved to the dead letter directory",
+		"id", entry.id, "error", deliveryErr, "directory", deadLetterDirName)
+	return nil
+}
+
+// Close stops delivering buffered operations and closes the underlying client. Undelivered operations are kept on
+// disk and will be delivered on the next start.
+func (c *client) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closing)
+	})
+	c.wg.Wait()
+
+	return c.bridgeClient.Close()
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (c *client) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,297 @@
+package buffered
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/backoff"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+func createArgs(t *testing.T) ArgsBufferedClient {
+	backoffHandler, _ := backoff.NewExponentialBackoff(backoff.ArgsExponentialBackoff{
+		Initial: time.Millisecond,
+		Max:     time.Millisecond * 5,
+	})
+
+	return ArgsBufferedClient{
+		Client:     &testscommon.ClientHandlerMock{},
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Backoff:    backoffHandler,
+		Path:       t.TempDir(),
+	}
+}
+
+func createBridgeOps(idx int) *sovereign.BridgeOperations {
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte(fmt.Sprintf("hash%d", idx)),
+			},
+		},
+	}
+}
+
+func TestNewBufferedClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client", func(t *testing.T) {
+		args := createArgs(t)
+		args.Client = nil
+
+		c, err := NewBufferedClient(args)
+		require.Equal(t, errNilBridgeClient, err)
+		require.Nil(t, c)
+	})
+	t.Run("nil marshaller", func(t *testing.T) {
+		args := createArgs(t)
+		args.Marshaller = nil
+
+		c, err := NewBufferedClient(args)
+		require.Equal(t, errNilMarshaller, err)
+		require.Nil(t, c)
+	})
+	t.Run("nil backoff", func(t *testing.T) {
+		args := createArgs(t)
+		args.Backoff = nil
+
+		c, err := NewBufferedClient(args)
+		require.Equal(t, errNilBackoff, err)
+		require.Nil(t, c)
+	})
+	t.Run("empty path", func(t *testing.T) {
+		args := createArgs(t)
+		args.Path = ""
+
+		c, err := NewBufferedClient(args)
+		require.Equal(t, errEmptyBufferPath, err)
+		require.Nil(t, c)
+	})
+	t.Run("should work", func(t *testing.T) {
+		c, err := NewBufferedClient(createArgs(t))
+		require.Nil(t, err)
+		require.False(t, c.IsInterfaceNil())
+		require.Nil(t, c.Close())
+	})
+}
+
+func TestClient_SendShouldDeliverInOrderAndRetry(t *testing.T) {
+	t.Parallel()
+
+	numOps := 10
+	numFailures := 3
+	numCalls := 0
+	mut := sync.Mutex{}
+	delivered := make([]*sovereign.BridgeOperations, 0, numOps)
+	idempotencyKeys := make(map[string]struct{})
+	allDelivered := make(chan struct{})
+
+	args := createArgs(t)
+	args.Client = &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			mut.Lock()
+			defer mut.Unlock()
+
+			numCalls++
+			if numCalls <= numFailures {
+				return nil, status.Error(codes.Unavailable, "server unreachable")
+			}
+
+			key := idempotency.FromOutgoingContext(ctx)
+			expectedKey, _ := idempotency.ComputeKey(args.Marshaller, data)
+			require.Equal(t, expectedKey, key)
+
+			idempotencyKeys[key] = struct{}{}
+			delivered = append(delivered, data)
+			if len(delivered) == numOps {
+				close(allDelivered)
+			}
+
+			return &sovereign.BridgeOperationsResponse{}, nil
+		},
+	}
+
+	c, _ := NewBufferedClient(args)
+	defer func() {
+		require.Nil(t, c.Close())
+	}()
+
+	for i := 0; i < numOps; i++ {
+		res, err := c.Send(context.Background(), createBridgeOps(i))
+		require.Nil(t, err)
+		require.Equal(t, &sovereign.BridgeOperationsResponse{}, res)
+	}
+
+	select {
+	case <-allDelivered:
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "timeout waiting for buffered operations to be delivered")
+	}
+
+	mut.Lock()
+	defer mut.Unlock()
+	for i := 0; i < numOps; i++ {
+		require.Equal(t, createBridgeOps(i), delivered[i])
+	}
+	require.Len(t, idempotencyKeys, numOps)
+	require.Equal(t, numOps+numFailures, numCalls)
+}
+
+func TestClient_UndeliveredOperationsShouldBeDeliveredAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(t)
+	args.Client = &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			return nil, status.Error(codes.Unavailable, "server unreachable")
+		},
+	}
+
+	c, _ := NewBufferedClient(args)
+	_, err := c.Send(context.Background(), createBridgeOps(0))
+	require.Nil(t, err)
+	_, err = c.Send(context.Background(), createBridgeOps(1))
+	require.Nil(t, err)
+	require.Nil(t, c.Close())
+
+	_, err = c.Send(context.Background(), createBridgeOps(2))
+	require.Equal(t, errClientClosed, err)
+
+	deliveredCh := make(chan *sovereign.BridgeOperations, 2)
+	args.Client = &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			deliveredCh <- data
+			return &sovereign.BridgeOperationsResponse{}, nil
+		},
+	}
+
+	c, _ = NewBufferedClient(args)
+	defer func() {
+		require.Nil(t, c.Close())
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case data := <-deliveredCh:
+			require.Equal(t, createBridgeOps(i), data)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timeout waiting for buffered operations to be delivered")
+		}
+	}
+}
+
+func TestClient_RejectedOperationsShouldBeMovedToDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	deliveredCh := make(chan *sovereign.BridgeOperations, 1)
+	args := createArgs(t)
+	args.Client = &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			if string(data.Data[0].Hash) == "hash0" {
+				return nil, status.Error(codes.InvalidArgument, "invalid hash length")
+			}
+
+			deliveredCh <- data
+			return &sovereign.BridgeOperationsResponse{}, nil
+		},
+	}
+
+	c, _ := NewBufferedClient(args)
+	_, err := c.Send(context.Background(), createBridgeOps(0))
+	require.Nil(t, err)
+	_, err = c.Send(context.Background(), createBridgeOps(1))
+	require.Nil(t, err)
+
+	select {
+	case data := <-deliveredCh:
+		require.Equal(t, createBridgeOps(1), data)
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "timeout waiting for buffered operations to be delivered")
+	}
+	require.Nil(t, c.Close())
+
+	deadLetterEntries, err := os.ReadDir(filepath.Join(args.Path, deadLetterDirName))
+	require.Nil(t, err)
+	require.Len(t, deadLetterEntries, 1)
+
+	rejected, err := os.ReadFile(filepath.Join(args.Path, deadLetterDirName, deadLetterEntries[0].Name()))
+	require.Nil(t, err)
+	expected, _ := args.Marshaller.Marshal(createBridgeOps(0))
+	require.Equal(t, expected, rejected)
+}
+
+func TestClient_CorruptedOperationsShouldBeMovedToDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	deliveredCh := make(chan *sovereign.BridgeOperations, 1)
+	args := createArgs(t)
+	args.Client = &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			deliveredCh <- data
+			return &sovereign.BridgeOperationsResponse{}, nil
+		},
+	}
+
+	corrupted := []byte("corrupted")
+	dq, _ := newDiskQueue(args.Path)
+	_, err := dq.push(corrupted)
+	require.Nil(t, err)
+
+	c, _ := NewBufferedClient(args)
+	_, err = c.Send(context.Background(), createBridgeOps(0))
+	require.Nil(t, err)
+
+	select {
+	case data := <-deliveredCh:
+		require.Equal(t, createBridgeOps(0), data)
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "timeout waiting for buffered operations to be delivered")
+	}
+	require.Nil(t, c.Close())
+
+	deadLetterEntries, err := os.ReadDir(filepath.Join(args.Path, deadLetterDirName))
+	require.Nil(t, err)
+	require.Len(t, deadLetterEntries, 1)
+
+	deadLetter, err := os.ReadFile(filepath.Join(args.Path, deadLetterDirName, deadLetterEntries[0].Name()))
+	require.Nil(t, err)
+	require.Equal(t, corrupted, deadLetter)
+}
+
+func TestClient_CloseShouldNotMoveUndeliveredOperationsToDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	sendStarted := make(chan struct{})
+	args := createArgs(t)
+	args.Client = &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			close(sendStarted)
+			<-ctx.Done()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		},
+	}
+
+	c, _ := NewBufferedClient(args)
+	_, err := c.Send(context.Background(), createBridgeOps(0))
+	require.Nil(t, err)
+	<-sendStarted
+	require.Nil(t, c.Close())
+
+	_, err = os.Stat(filepath.Join(args.Path, deadLetterDirName))
+	require.True(t, os.IsNotExist(err))
+
+	dq, _ := newDiskQueue(args.Path)
+	require.Equal(t, 1, dq.len())
+}
@@ -0,0 +1,208 @@
+package buffered
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	entryFileExtension = ".op"
+	tmpFileExtension   = ".tmp"
+	deadLetterDirName  = "dead-letter"
+	filePermissions    = 0600
+	dirPermissions     = 0700
+)
+
+type queueEntry struct {
+	id   uint64
+	data []byte
+}
+
+// diskQueue is a FIFO queue persisted in a directory, one file per entry. Entries are written to a temporary file
+// and renamed, so that a crash never leaves a partially written entry in the queue.
+type diskQueue struct {
+	mut    sync.Mutex
+	dir    string
+	ids    []uint64
+	nextID uint64
+}
+
+func newDiskQueue(dir string) (*diskQueue, error) {
+	if len(dir) == 0 {
+		return nil, errEmptyBufferPath
+	}
+
+	err := os.MkdirAll(dir, dirPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := loadEntryIDs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	nextID := uint64(0)
+	if len(ids) != 0 {
+		nextID = ids[len(ids)-1] + 1
+	}
+
+	return &diskQueue{
+		dir:    dir,
+		ids:    ids,
+		nextID: nextID,
+	}, nil
+}
+
+func loadEntryIDs(dir string) ([]uint64, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(files))
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, entryFileExtension) {
+			continue
+		}
+
+		id, errParse := strconv.ParseUint(strings.TrimSuffix(name, entryFileExtension), 10, 64)
+		if errParse != nil {
+			log.Warn("ignoring unknown file in buffer directory", "file", name)
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids, nil
+}
+
+func (dq *diskQueue) push(data []byte) (uint64, error) {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	id := dq.nextID
+	tmpPath := dq.entryPath(id) + tmpFileExtension
+	err := writeFileSynced(tmpPath, data)
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.Rename(tmpPath, dq.entryPath(id))
+	if err != nil {
+		return 0, err
+	}
+
+	dq.ids = append(dq.ids, id)
+	dq.nextID++
+
+	return id, nil
+}
+
+func writeFileSynced(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// peek returns the oldest entry, without removing it. It returns nil if the queue is empty.
+func (dq *diskQueue) peek() (*queueEntry, error) {
+	dq.mut.Lock()
+	if len(dq.ids) == 0 {
+		dq.mut.Unlock()
+		return nil, nil
+	}
+	id := dq.ids[0]
+	dq.mut.Unlock()
+
+	data, err := os.ReadFile(dq.entryPath(id))
+	if err != nil {
+		return nil, fmt.Errorf("%w, entry id: %d", err, id)
+	}
+
+	return &queueEntry{
+		id:   id,
+		data: data,
+	}, nil
+}
+
+// remove deletes the oldest entry, if it has the provided id
+func (dq *diskQueue) remove(id uint64) error {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	if len(dq.ids) == 0 || dq.ids[0] != id {
+		return fmt.Errorf("%w, entry id: %d", errEntryNotFound, id)
+	}
+
+	err := os.Remove(dq.entryPath(id))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	dq.ids = dq.ids[1:]
+	return nil
+}
+
+// moveToDeadLetter moves the oldest entry, if it has the provided id, to the dead letter directory, so that it is kept
+// for inspection without blocking the entries behind it
+func (dq *diskQueue) moveToDeadLetter(id uint64) error {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	if len(dq.ids) == 0 || dq.ids[0] != id {
+		return fmt.Errorf("%w, entry id: %d", errEntryNotFound, id)
+	}
+
+	deadLetterDir := filepath.Join(dq.dir, deadLetterDirName)
+	err := os.MkdirAll(deadLetterDir, dirPermissions)
+	if err != nil {
+		return err
+	}
+
+	entryPath := dq.entryPath(id)
+	err = os.Rename(entryPath, filepath.Join(deadLetterDir, filepath.Base(entryPath)))
+	if err != nil {
+		return err
+	}
+
+	dq.ids = dq.ids[1:]
+	return nil
+}
+
+func (dq *diskQueue) len() int {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	return len(dq.ids)
+}
+
+func (dq *diskQueue) entryPath(id uint64) string {
+	return filepath.Join(dq.dir, fmt.Sprintf("%020d%s", id, entryFileExtension))
+}
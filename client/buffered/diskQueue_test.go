@@ -0,0 +1,80 @@
+package buffered
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty dir", func(t *testing.T) {
+		dq, err := newDiskQueue("")
+		require.Equal(t, errEmptyBufferPath, err)
+		require.Nil(t, dq)
+	})
+	t.Run("push, peek and remove in order", func(t *testing.T) {
+		dq, _ := newDiskQueue(t.TempDir())
+
+		entry, err := dq.peek()
+		require.Nil(t, err)
+		require.Nil(t, entry)
+
+		id0, _ := dq.push([]byte("data0"))
+		id1, _ := dq.push([]byte("data1"))
+		require.Equal(t, 2, dq.len())
+
+		entry, err = dq.peek()
+		require.Nil(t, err)
+		require.Equal(t, &queueEntry{id: id0, data: []byte("data0")}, entry)
+
+		err = dq.remove(id1)
+		require.ErrorIs(t, err, errEntryNotFound)
+
+		require.Nil(t, dq.remove(id0))
+		entry, _ = dq.peek()
+		require.Equal(t, &queueEntry{id: id1, data: []byte("data1")}, entry)
+	})
+	t.Run("should reload entries and ignore unfinished writes", func(t *testing.T) {
+		dir := t.TempDir()
+		dq, _ := newDiskQueue(dir)
+		_, _ = dq.push([]byte("data0"))
+		id1, _ := dq.push([]byte("data1"))
+		_ = dq.remove(0)
+
+		err := os.WriteFile(filepath.Join(dir, "00000000000000000005.op.tmp"), []byte("partial"), filePermissions)
+		require.Nil(t, err)
+
+		dq, _ = newDiskQueue(dir)
+		require.Equal(t, 1, dq.len())
+
+		entry, _ := dq.peek()
+		require.Equal(t, &queueEntry{id: id1, data: []byte("data1")}, entry)
+
+		id2, _ := dq.push([]byte("data2"))
+		require.Equal(t, id1+1, id2)
+	})
+	t.Run("move to dead letter should keep the entry out of the queue", func(t *testing.T) {
+		dir := t.TempDir()
+		dq, _ := newDiskQueue(dir)
+		id0, _ := dq.push([]byte("data0"))
+		id1, _ := dq.push([]byte("data1"))
+
+		err := dq.moveToDeadLetter(id1)
+		require.ErrorIs(t, err, errEntryNotFound)
+
+		require.Nil(t, dq.moveToDeadLetter(id0))
+		entry, _ := dq.peek()
+		require.Equal(t, &queueEntry{id: id1, data: []byte("data1")}, entry)
+
+		data, err := os.ReadFile(filepath.Join(dir, deadLetterDirName, filepath.Base(dq.entryPath(id0))))
+		require.Nil(t, err)
+		require.Equal(t, []byte("data0"), data)
+
+		dq, _ = newDiskQueue(dir)
+		require.Equal(t, 1, dq.len())
+	})
+}
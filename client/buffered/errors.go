@@ -0,0 +1,19 @@
+package buffered
+
+import "errors"
+
+var errNilBridgeClient = errors.New("nil bridge client provided")
+
+var errNilMarshaller = errors.New("nil marshaller provided")
+
+var errNilBackoff = errors.New("nil backoff handler provided")
+
+var errEmptyBufferPath = errors.New("empty buffer path provided")
+
+var errEntryNotFound = errors.New("buffered entry not found")
+
+var errNilBridgeOperations = errors.New("nil bridge operations provided")
+
+var errClientClosed = errors.New("buffered client is closed")
+
+var errCorruptedEntry = errors.New("corrupted buffered bridge operations")
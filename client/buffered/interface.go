@@ -0,0 +1,21 @@
+package buffered
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+)
+
+// BridgeClient defines the client used to deliver the buffered bridge operations to the server
+type BridgeClient interface {
+	Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// BackoffHandler defines the delay policy between failed delivery attempts
+type BackoffHandler interface {
+	Wait(done <-chan struct{}) bool
+	Reset()
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+)
+
+// defaultBenchHasher is used to size the generated hashes when no hasher is provided
+const defaultBenchHasher = "sha256"
+
+// counterSize is the size of the counter suffix of the generated hashes
+const counterSize = 8
+
+type benchArgs struct {
+	rate           int
+	duration       time.Duration
+	concurrency    int
+	dataPerRequest int
+	opsPerData     int
+	hasher         hashing.Hasher
+	hashSize       int
+}
+
+func bench(ctx *cli.Context) error {
+	args, err := getBenchArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	bridgeClient, _, err := createBridgeClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeBridgeClient(bridgeClient)
+
+	log.Info("starting benchmark",
+		"rate", args.rate,
+		"duration", args.duration,
+		"concurrency", args.concurrency,
+		"bridge data per request", args.dataPerRequest,
+		"operations per bridge data", args.opsPerData)
+
+	stats := runBench(bridgeClient, args)
+	stats.print(ctx.App.Writer)
+
+	return nil
+}
+
+func getBenchArgs(ctx *cli.Context) (*benchArgs, error) {
+	args := &benchArgs{
+		rate:           ctx.Int(benchRate.Name),
+		duration:       time.Second * time.Duration(ctx.Int(benchDuration.Name)),
+		concurrency:    ctx.Int(benchConcurrency.Name),
+		dataPerRequest: ctx.Int(benchDataPerRequest.Name),
+		opsPerData:     ctx.Int(benchOpsPerData.Name),
+	}
+
+	if args.rate < 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidBenchRate, args.rate)
+	}
+	if args.duration <= 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidBenchDuration, args.duration)
+	}
+	if args.concurrency < 1 {
+		return nil, fmt.Errorf("%w: %d", errInvalidBenchConcurrency, args.concurrency)
+	}
+	if args.dataPerRequest < 1 || args.opsPerData < 1 {
+		return nil, fmt.Errorf("%w: bridge data per request: %d, operations per bridge data: %d",
+			errInvalidBenchRequestSize, args.dataPerRequest, args.opsPerData)
+	}
+
+	hasherType := ctx.String(hasherName.Name)
+	if len(hasherType) == 0 {
+		hasherType = defaultBenchHasher
+	}
+	hasher, err := factory.NewHasher(hasherType)
+	if err != nil {
+		return nil, err
+	}
+	args.hashSize = hasher.Size()
+	if args.hashSize <= counterSize {
+		return nil, fmt.Errorf("%w: %d bytes, hasher %s", errInvalidBenchHashSize, args.hashSize, hasherType)
+	}
+	if len(ctx.String(hasherName.Name)) != 0 {
+		args.hasher = hasher
+	}
+
+	return args, nil
+}
+
+// runBench generates requests at the target rate, until the duration elapses. Requests are scheduled at fixed
+// offsets from the start, so a slow server results in requests waiting for a free sender, which is accounted in
+// their latency, instead of in a lower request rate being silently measured.
+func runBench(bridgeClient client.ClientHandler, args *benchArgs) *benchStats {
+	generator := newRequestsGenerator(args)
+	stats := newBenchStats()
+	scheduled := make(chan time.Time, args.concurrency)
+
+	wg := sync.WaitGroup{}
+	wg.Add(args.concurrency)
+	for i := 0; i < args.concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for scheduledAt := range scheduled {
+				res, err := bridgeClient.Send(context.Background(), generator.next())
+				stats.record(time.Since(scheduledAt), len(res.GetTxHashes()), err)
+			}
+		}()
+	}
+
+	start := time.Now()
+	for idx := 0; ; idx++ {
+		scheduledAt := start
+		if args.rate > 0 {
+			scheduledAt = start.Add(time.Duration(idx) * time.Second / time.Duration(args.rate))
+			time.Sleep(time.Until(scheduledAt))
+		} else {
+			scheduledAt = time.Now()
+		}
+
+		if time.Since(start) >= args.duration {
+			break
+		}
+
+		scheduled <- scheduledAt
+	}
+
+	close(scheduled)
+	wg.Wait()
+	stats.finish(time.Since(start))
+
+	return stats
+}
+
+type requestsGenerator struct {
+	mut   sync.Mutex
+	args  *benchArgs
+	runID []byte
+	nonce uint64
+}
+
+func newRequestsGenerator(args *benchArgs) *requestsGenerator {
+	// each run uses a random prefix for the generated hashes, so that requests are never deduplicated by the server.
+	// Together with the counter, generated hashes have the size of the hasher used by the server.
+	runID := make([]byte, args.hashSize-counterSize)
+	_, _ = rand.Read(runID)
+
+	return &requestsGenerator{
+		args:  args,
+		runID: runID,
+	}
+}
+
+func (rg *requestsGenerator) next() *sovereign.BridgeOperations {
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: make([]*sovereign.BridgeOutGoingData, 0, rg.args.dataPerRequest),
+	}
+
+	for i := 0; i < rg.args.dataPerRequest; i++ {
+		bridgeData := &sovereign.BridgeOutGoingData{
+			Hash:                rg.nextHash(),
+			OutGoingOperations:  make([]*sovereign.OutGoingOperation, 0, rg.args.opsPerData),
+			AggregatedSignature: []byte("aggregatedSignature"),
+			LeaderSignature:     []byte("leaderSignature"),
+		}
+
+		for j := 0; j < rg.args.opsPerData; j++ {
+			bridgeData.OutGoingOperations = append(bridgeData.OutGoingOperations, &sovereign.OutGoingOperation{
+				Hash: rg.nextHash(),
+				Data: []byte("bench"),
+			})
+		}
+
+		if rg.args.hasher != nil {
+			bridgeData.Hash = operations.ComputeHashOfHashes(rg.args.hasher, bridgeData.OutGoingOperations)
+		}
+
+		bridgeOps.Data = append(bridgeOps.Data, bridgeData)
+	}
+
+	return bridgeOps
+}
+
+func (rg *requestsGenerator) nextHash() []byte {
+	rg.mut.Lock()
+	defer rg.mut.Unlock()
+
+	rg.nonce++
+	return binary.BigEndian.AppendUint64(append([]byte{}, rg.runID...), rg.nonce)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+var reportedPercentiles = []float64{50, 90, 95, 99}
+
+type benchStats struct {
+	mut       sync.Mutex
+	latencies []time.Duration
+	numErrors int
+	numTxs    int
+	errors    map[string]int
+	elapsed   time.Duration
+}
+
+func newBenchStats() *benchStats {
+	return &benchStats{
+		latencies: make([]time.Duration, 0),
+		errors:    make(map[string]int),
+	}
+}
+
+func (bs *benchStats) record(latency time.Duration, numTxs int, err error) {
+	bs.mut.Lock()
+	defer bs.mut.Unlock()
+
+	bs.latencies = append(bs.latencies, latency)
+	if err != nil {
+		bs.numErrors++
+		bs.errors[err.Error()]++
+		return
+	}
+
+	bs.numTxs += numTxs
+}
+
+func (bs *benchStats) finish(elapsed time.Duration) {
+	bs.mut.Lock()
+	defer bs.mut.Unlock()
+
+	bs.elapsed = elapsed
+	sort.Slice(bs.latencies, func(i, j int) bool {
+		return bs.latencies[i] < bs.latencies[j]
+	})
+}
+
+// percentile returns the latency below which the provided percentage of requests fall, using the nearest rank method
+func (bs *benchStats) percentile(p float64) time.Duration {
+	if len(bs.latencies) == 0 {
+		return 0
+	}
+
+	rank := int(p/100*float64(len(bs.latencies))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(bs.latencies) {
+		rank = len(bs.latencies) - 1
+	}
+
+	return bs.latencies[rank]
+}
+
+func (bs *benchStats) print(w io.Writer) {
+	bs.mut.Lock()
+	defer bs.mut.Unlock()
+
+	numRequests := len(bs.latencies)
+	seconds := bs.elapsed.Seconds()
+	if seconds == 0 {
+		seconds = 1
+	}
+
+	printStat(w, "duration", bs.elapsed.Round(time.Millisecond))
+	printStat(w, "requests", numRequests)
+	printStat(w, "throughput", fmt.Sprintf("%.2f requests/s, %.2f txs/s", float64(numRequests)/seconds, float64(bs.numTxs)/seconds))
+
+	errorRate := 0.0
+	if numRequests != 0 {
+		errorRate = float64(bs.numErrors) / float64(numRequests) * 100
+	}
+	printStat(w, "errors", fmt.Sprintf("%d (%.2f%%)", bs.numErrors, errorRate))
+	for errMsg, count := range bs.errors {
+		_, _ = fmt.Fprintf(w, "  %d x %s\n", count, errMsg)
+	}
+
+	if numRequests == 0 {
+		return
+	}
+
+	printStat(w, "latency min", bs.latencies[0])
+	for _, p := range reportedPercentiles {
+		printStat(w, fmt.Sprintf("latency p%v", p), bs.percentile(p))
+	}
+	printStat(w, "latency max", bs.latencies[numRequests-1])
+}
+
+func printStat(w io.Writer, name string, value interface{}) {
+	_, _ = fmt.Fprintf(w, "%-16s%v\n", name+":", value)
+}
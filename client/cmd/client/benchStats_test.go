@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createFinishedStats(latencies ...time.Duration) *benchStats {
+	stats := newBenchStats()
+	for _, latency := range latencies {
+		stats.record(latency, 1, nil)
+	}
+	stats.finish(time.Second)
+
+	return stats
+}
+
+func TestBenchStats_Percentile(t *testing.T) {
+	t.Parallel()
+
+	tenLatencies := []time.Duration{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}
+	tests := []struct {
+		name       string
+		latencies  []time.Duration
+		percentile float64
+		expected   time.Duration
+	}{
+		{name: "no latencies", latencies: nil, percentile: 50, expected: 0},
+		{name: "single latency", latencies: []time.Duration{7}, percentile: 99, expected: 7},
+		{name: "p0 should be the min", latencies: tenLatencies, percentile: 0, expected: 1},
+		{name: "p50", latencies: tenLatencies, percentile: 50, expected: 5},
+		{name: "p90", latencies: tenLatencies, percentile: 90, expected: 9},
+		{name: "p95 should round to the nearest rank", latencies: tenLatencies, percentile: 95, expected: 10},
+		{name: "p99", latencies: tenLatencies, percentile: 99, expected: 10},
+		{name: "p100 should be the max", latencies: tenLatencies, percentile: 100, expected: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := createFinishedStats(tt.latencies...)
+			require.Equal(t, tt.expected, stats.percentile(tt.percentile))
+		})
+	}
+}
+
+func TestBenchStats_Record(t *testing.T) {
+	t.Parallel()
+
+	stats := newBenchStats()
+	stats.record(time.Millisecond*3, 2, nil)
+	stats.record(time.Millisecond*1, 5, errors.New("unavailable"))
+	stats.record(time.Millisecond*2, 5, errors.New("unavailable"))
+	stats.record(time.Millisecond*4, 1, errors.New("invalid"))
+	stats.record(time.Millisecond*5, 3, nil)
+	stats.finish(time.Second)
+
+	require.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond, 4 * time.Millisecond, 5 * time.Millisecond}, stats.latencies)
+	require.Equal(t, 5, stats.numTxs)
+	require.Equal(t, 3, stats.numErrors)
+	require.Equal(t, map[string]int{"unavailable": 2, "invalid": 1}, stats.errors)
+	require.Equal(t, time.Second, stats.elapsed)
+}
+
+func TestBenchStats_Print(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no requests", func(t *testing.T) {
+		stats := newBenchStats()
+		stats.finish(0)
+
+		buff := bytes.NewBuffer(nil)
+		stats.print(buff)
+		require.Contains(t, buff.String(), "throughput:     0.00 requests/s, 0.00 txs/s")
+		require.Contains(t, buff.String(), "errors:         0 (0.00%)")
+		require.NotContains(t, buff.String(), "latency")
+	})
+	t.Run("requests with errors", func(t *testing.T) {
+		stats := newBenchStats()
+		stats.record(time.Millisecond*10, 4, nil)
+		stats.record(time.Millisecond*30, 4, nil)
+		stats.record(time.Millisecond*20, 4, nil)
+		stats.record(time.Millisecond*40, 0, errors.New("unavailable"))
+		stats.finish(time.Second * 2)
+
+		buff := bytes.NewBuffer(nil)
+		stats.print(buff)
+		output := buff.String()
+		require.Contains(t, output, "duration:       2s")
+		require.Contains(t, output, "requests:       4")
+		require.Contains(t, output, "throughput:     2.00 requests/s, 6.00 txs/s")
+		require.Contains(t, output, "errors:         1 (25.00%)")
+		require.Contains(t, output, "  1 x unavailable")
+		require.Contains(t, output, "latency min:    10ms")
+		require.Contains(t, output, "latency p50:    20ms")
+		require.Contains(t, output, "latency max:    40ms")
+	})
+}
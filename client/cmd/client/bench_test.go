@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+func createCliContext(t *testing.T, flags []cli.Flag, arguments ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, f := range flags {
+		f.Apply(set)
+	}
+	require.Nil(t, set.Parse(arguments))
+
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
+
+func createBenchCliContext(t *testing.T, arguments ...string) *cli.Context {
+	return createCliContext(t, []cli.Flag{
+		benchRate,
+		benchDuration,
+		benchConcurrency,
+		benchDataPerRequest,
+		benchOpsPerData,
+		hasherName,
+	}, arguments...)
+}
+
+func TestGetBenchArgs(t *testing.T) {
+	t.Parallel()
+
+	errorTests := []struct {
+		name          string
+		arguments     []string
+		expectedError error
+	}{
+		{name: "negative rate", arguments: []string{"--rate", "-1"}, expectedError: errInvalidBenchRate},
+		{name: "zero duration", arguments: []string{"--duration", "0"}, expectedError: errInvalidBenchDuration},
+		{name: "zero concurrency", arguments: []string{"--concurrency", "0"}, expectedError: errInvalidBenchConcurrency},
+		{name: "zero bridge data per request", arguments: []string{"--data-per-request", "0"}, expectedError: errInvalidBenchRequestSize},
+		{name: "zero operations per bridge data", arguments: []string{"--ops-per-data", "0"}, expectedError: errInvalidBenchRequestSize},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := getBenchArgs(createBenchCliContext(t, tt.arguments...))
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Nil(t, args)
+		})
+	}
+
+	t.Run("unknown hasher", func(t *testing.T) {
+		args, err := getBenchArgs(createBenchCliContext(t, "--hasher", "md5"))
+		require.NotNil(t, err)
+		require.Nil(t, args)
+	})
+	t.Run("default values should use the default hasher size", func(t *testing.T) {
+		args, err := getBenchArgs(createBenchCliContext(t))
+		require.Nil(t, err)
+		require.Equal(t, 10, args.rate)
+		require.Equal(t, 30*time.Second, args.duration)
+		require.Equal(t, 4, args.concurrency)
+		require.Equal(t, 1, args.dataPerRequest)
+		require.Equal(t, 5, args.opsPerData)
+		require.Nil(t, args.hasher)
+		require.Equal(t, 32, args.hashSize)
+	})
+	t.Run("provided hasher should set the hash size", func(t *testing.T) {
+		args, err := getBenchArgs(createBenchCliContext(t, "--rate", "0", "--duration", "2", "--hasher", "blake2b"))
+		require.Nil(t, err)
+		require.Equal(t, 0, args.rate)
+		require.Equal(t, 2*time.Second, args.duration)
+		require.NotNil(t, args.hasher)
+		require.Equal(t, args.hasher.Size(), args.hashSize)
+	})
+}
+
+func TestRequestsGenerator_Next(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generated hashes should be unique and have the hash size", func(t *testing.T) {
+		generator := newRequestsGenerator(&benchArgs{dataPerRequest: 2, opsPerData: 3, hashSize: 20})
+
+		hashes := make(map[string]struct{})
+		for i := 0; i < 10; i++ {
+			bridgeOps := generator.next()
+			require.Len(t, bridgeOps.Data, 2)
+			for _, bridgeData := range bridgeOps.Data {
+				require.Len(t, bridgeData.OutGoingOperations, 3)
+				require.Len(t, bridgeData.Hash, 20)
+				hashes[string(bridgeData.Hash)] = struct{}{}
+				for _, operation := range bridgeData.OutGoingOperations {
+					require.Len(t, operation.Hash, 20)
+					hashes[string(operation.Hash)] = struct{}{}
+				}
+			}
+		}
+		require.Len(t, hashes, 10*2*(1+3))
+	})
+	t.Run("hasher should be used for the bridge data hash", func(t *testing.T) {
+		hasher, _ := factory.NewHasher("keccak")
+		generator := newRequestsGenerator(&benchArgs{dataPerRequest: 1, opsPerData: 2, hashSize: hasher.Size(), hasher: hasher})
+
+		bridgeData := generator.next().Data[0]
+		require.Equal(t, operations.ComputeHashOfHashes(hasher, bridgeData.OutGoingOperations), bridgeData.Hash)
+	})
+}
+
+func TestRunBench(t *testing.T) {
+	t.Parallel()
+
+	bridgeClient := &testscommon.ClientHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			return &sovereign.BridgeOperationsResponse{TxHashes: []string{"txHash"}}, nil
+		},
+	}
+
+	stats := runBench(bridgeClient, &benchArgs{
+		rate:           100,
+		duration:       time.Millisecond * 100,
+		concurrency:    2,
+		dataPerRequest: 1,
+		opsPerData:     1,
+		hashSize:       32,
+	})
+	require.NotEmpty(t, stats.latencies)
+	require.Equal(t, len(stats.latencies), stats.numTxs)
+	require.Zero(t, stats.numErrors)
+	require.True(t, stats.elapsed >= time.Millisecond*100)
+}
@@ -0,0 +1,15 @@
+package main
+
+import "errors"
+
+var errNoJournalFiles = errors.New("no journal files provided")
+
+var errInvalidBenchRate = errors.New("invalid benchmark rate")
+
+var errInvalidBenchDuration = errors.New("invalid benchmark duration")
+
+var errInvalidBenchConcurrency = errors.New("invalid benchmark concurrency")
+
+var errInvalidBenchRequestSize = errors.New("invalid benchmark request size")
+
+var errInvalidBenchHashSize = errors.New("invalid benchmark hash size")
@@ -14,4 +14,67 @@ var (
 			" log level.",
 		Value: "*:" + logger.LogDebug.String(),
 	}
+	envFile = cli.StringFlag{
+		Name:  "env-file",
+		Usage: "This flag specifies the `path` of the .env file holding the client's config.",
+		Value: ".env",
+	}
+	connectTimeout = cli.IntFlag{
+		Name:  "connect-timeout",
+		Usage: "This flag specifies the maximum time, in `seconds`, to wait for the connection with the server.",
+		Value: 30,
+	}
+	inputFiles = cli.StringSliceFlag{
+		Name: "file",
+		Usage: "This flag specifies a `path` to a file holding one bridge operations request. It can be provided multiple " +
+			"times, requests being sent in order. Use - or omit it to read one request from stdin.",
+	}
+	inputFormat = cli.StringFlag{
+		Name: "format",
+		Usage: "This flag specifies the `format` of the input files: json (hex encoded byte fields) or proto. If not set, " +
+			"it is detected from the file extension (.json, .pb, .proto), defaulting to json for stdin.",
+	}
+	hasherName = cli.StringFlag{
+		Name: "hasher",
+		Usage: "If set, the hash of each bridge data is overwritten with the hash of its operations hashes, computed " +
+			"with this `hasher` (e.g.: sha256, keccak, blake2b). It should be the same hasher as the one used by the server.",
+	}
+	journalOutput = cli.StringFlag{
+		Name:  "journal",
+		Usage: "If set, all sent bridge operations are appended to this journal `file`, so they can be replayed later.",
+	}
+	journalFiles = cli.StringSliceFlag{
+		Name: "journal",
+		Usage: "This flag specifies a journal `file` to replay, along with its rotated backups (file.N ... file.1). It " +
+			"can be provided multiple times, files being replayed in order.",
+	}
+	dryRun = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Boolean option for only printing the journaled bridge operations, without sending them.",
+	}
+	benchRate = cli.IntFlag{
+		Name:  "rate",
+		Usage: "This flag specifies the target number of bridge operations `requests` per second. Use 0 to send as fast as possible.",
+		Value: 10,
+	}
+	benchDuration = cli.IntFlag{
+		Name:  "duration",
+		Usage: "This flag specifies for how many `seconds` requests are generated.",
+		Value: 30,
+	}
+	benchConcurrency = cli.IntFlag{
+		Name:  "concurrency",
+		Usage: "This flag specifies the maximum `number` of requests in flight.",
+		Value: 4,
+	}
+	benchDataPerRequest = cli.IntFlag{
+		Name:  "data-per-request",
+		Usage: "This flag specifies the `number` of bridge data in each generated request.",
+		Value: 1,
+	}
+	benchOpsPerData = cli.IntFlag{
+		Name:  "ops-per-data",
+		Usage: "This flag specifies the `number` of outgoing operations in each generated bridge data.",
+		Value: 5,
+	}
 )
@@ -0,0 +1,8 @@
+package main
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+
+type journalWriter interface {
+	Write(data *sovereign.BridgeOperations) error
+	Close() error
+}
@@ -2,11 +2,9 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"os"
-	"sync"
+	"time"
 
-	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"github.com/joho/godotenv"
 	"github.com/urfave/cli"
@@ -27,10 +25,55 @@ const (
 
 func main() {
 	app := cli.NewApp()
-	app.Name = "GRPC Client"
-	app.Action = startClient
+	app.Name = "Sovereign bridge client"
+	app.Usage = "Operator tool to submit bridge operations to a sovereign bridge server"
 	app.Flags = []cli.Flag{
 		logLevel,
+		envFile,
+		connectTimeout,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "send",
+			Usage:  "Sends bridge operations read from json or protobuf files or stdin and prints the sent tx hashes",
+			Action: sendOperations,
+			Flags: []cli.Flag{
+				inputFiles,
+				inputFormat,
+				hasherName,
+				journalOutput,
+			},
+		},
+		{
+			Name:   "replay",
+			Usage:  "Resends bridge operations from journal files",
+			Action: replayJournal,
+			Flags: []cli.Flag{
+				journalFiles,
+				dryRun,
+			},
+		},
+		{
+			Name:   "ping",
+			Usage:  "Checks the tls handshake and the grpc connectivity with the server",
+			Action: ping,
+		},
+		{
+			Name: "bench",
+			Usage: "Sends synthetic bridge operations at a target rate and reports latency percentiles, error rate " +
+				"and throughput",
+			Description: "No real chain is needed: the server can use the local fake proxy from " +
+				"testkit/fakeProxy/cmd/fakeProxy as its proxy.",
+			Action: bench,
+			Flags: []cli.Flag{
+				benchRate,
+				benchDuration,
+				benchConcurrency,
+				benchDataPerRequest,
+				benchOpsPerData,
+				hasherName,
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -40,100 +83,37 @@ func main() {
 	}
 }
 
-func startClient(ctx *cli.Context) error {
-	cfg, err := loadConfig()
+func createBridgeClient(ctx *cli.Context) (client.ClientHandler, *config.ClientConfig, error) {
+	cfg, err := loadConfig(ctx.GlobalString(envFile.Name))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	err = initializeLogger(ctx)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	log.Info("starting client...")
+	timeout := time.Second * time.Duration(ctx.GlobalInt(connectTimeout.Name))
+	connectCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-	bridgeClient, err := client.CreateClient(cfg)
+	log.Info("starting client...")
+	bridgeClient, err := client.CreateClientWithContext(connectCtx, cfg)
 	if err != nil {
-		return err
-	}
-
-	defer func() {
-		err = bridgeClient.Close()
-		log.LogIfError(err)
-	}()
-
-	return sendData(bridgeClient)
-}
-
-func sendData(bridgeClient client.ClientHandler) error {
-	txHashes := make(map[string]struct{})
-	mut := sync.RWMutex{}
-
-	numBridgeOps := 5
-	expectedNumBridgeTxs := 3 * numBridgeOps
-	wg := sync.WaitGroup{}
-	wg.Add(expectedNumBridgeTxs)
-
-	for i := 0; i < numBridgeOps; i++ {
-		hash := []byte(fmt.Sprintf("hash_%d", i))
-		log.Info("sending data", "hash", hash)
-
-		go func() {
-			res, errSend := bridgeClient.Send(context.Background(), &sovereign.BridgeOperations{
-				Data: []*sovereign.BridgeOutGoingData{
-					{
-						Hash: hash,
-						OutGoingOperations: []*sovereign.OutGoingOperation{
-							{
-								Hash: []byte("opHash1"),
-								Data: []byte("bridgeOp1"),
-							},
-							{
-								Hash: []byte("opHash2"),
-								Data: []byte("bridgeOp2"),
-							},
-						},
-						AggregatedSignature: []byte("aggregatedSig"),
-						LeaderSignature:     []byte("leaderSig"),
-					},
-				},
-			})
-			if errSend != nil {
-				log.Error("error sending bridge data", "error", errSend)
-				wg.Done()
-				return
-			}
-
-			addTxHashes(res.TxHashes, txHashes, &mut, &wg)
-		}()
+		return nil, nil, err
 	}
 
-	wg.Wait()
-
-	numSentTxs := len(txHashes)
-	if numSentTxs != expectedNumBridgeTxs {
-		return fmt.Errorf("did not send all txs; expected num send txs: %d, received: %d",
-			expectedNumBridgeTxs, numSentTxs)
-	}
-
-	return nil
+	return bridgeClient, cfg, nil
 }
 
-func addTxHashes(txHashes []string, txHashesMap map[string]struct{}, mut *sync.RWMutex, wg *sync.WaitGroup) {
-	for _, txHash := range txHashes {
-		log.Info("received", "tx hash", txHash)
-
-		mut.Lock()
-		txHashesMap[txHash] = struct{}{}
-		mut.Unlock()
-
-		wg.Done()
-	}
+func closeBridgeClient(bridgeClient client.ClientHandler) {
+	err := bridgeClient.Close()
+	log.LogIfError(err)
 }
 
-func loadConfig() (*config.ClientConfig, error) {
-	err := godotenv.Load(".env")
+func loadConfig(envFilePath string) (*config.ClientConfig, error) {
+	err := godotenv.Load(envFilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+)
+
+func ping(ctx *cli.Context) error {
+	cfg, err := loadConfig(ctx.GlobalString(envFile.Name))
+	if err != nil {
+		return err
+	}
+
+	err = initializeLogger(ctx)
+	if err != nil {
+		return err
+	}
+
+	timeout := time.Second * time.Duration(ctx.GlobalInt(connectTimeout.Name))
+	err = checkTLS(ctx, cfg.CertificateCfg, cfg.GRPCHost, cfg.GRPCPort, timeout)
+	if err != nil {
+		return fmt.Errorf("tls check failed: %w", err)
+	}
+
+	bridgeClient, _, err := createBridgeClient(ctx)
+	if err != nil {
+		return fmt.Errorf("grpc connection failed: %w", err)
+	}
+	defer closeBridgeClient(bridgeClient)
+
+	requestCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	_, err = bridgeClient.Send(requestCtx, &sovereign.BridgeOperations{})
+	if err != nil {
+		return fmt.Errorf("grpc request failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(ctx.App.Writer, "grpc: ok, round trip: %s\n", time.Since(start))
+	return nil
+}
+
+func checkTLS(ctx *cli.Context, certCfg cert.FileCfg, host string, port string, timeout time.Duration) error {
+	tlsConfig, err := cert.LoadTLSClientConfig(certCfg)
+	if err != nil {
+		return err
+	}
+	tlsConfig.ServerName = host
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", net.JoinHostPort(host, port), tlsConfig)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	state := conn.ConnectionState()
+	_, _ = fmt.Fprintf(ctx.App.Writer, "tls: ok, version: %s, cipher suite: %s\n",
+		tls.VersionName(state.Version), tls.CipherSuiteName(state.CipherSuite))
+
+	for _, peerCert := range state.PeerCertificates {
+		_, _ = fmt.Fprintf(ctx.App.Writer, "server certificate: %s, expires: %s\n",
+			peerCert.Subject.String(), peerCert.NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/journal"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+)
+
+func replayJournal(ctx *cli.Context) error {
+	paths := ctx.StringSlice(journalFiles.Name)
+	if len(paths) == 0 {
+		return errNoJournalFiles
+	}
+
+	var bridgeClient client.ClientHandler
+	if !ctx.Bool(dryRun.Name) {
+		var err error
+		bridgeClient, _, err = createBridgeClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeBridgeClient(bridgeClient)
+	}
+
+	numReplayed, err := replayFiles(ctx.App.Writer, bridgeClient, expandJournalFiles(paths))
+	if err != nil {
+		return err
+	}
+
+	log.Info("replayed journal", "no. of bridge operations requests", numReplayed, "dry run", bridgeClient == nil)
+	return nil
+}
+
+// expandJournalFiles adds the rotated backups of each journal file, oldest first, so that recorded bridge operations
+// are replayed in the order in which they were sent
+func expandJournalFiles(paths []string) []string {
+	files := make([]string, 0, len(paths))
+	for _, path := range paths {
+		files = append(files, journal.ListFiles(path)...)
+	}
+
+	return files
+}
+
+// replayFiles replays the entries of the journal files in order, returning the number of replayed entries. If no
+// client is provided, entries are only printed.
+func replayFiles(output io.Writer, bridgeClient client.ClientHandler, paths []string) (int, error) {
+	numReplayed := 0
+	for _, path := range paths {
+		err := journal.ReadFile(path, func(entry *journal.Entry) error {
+			numReplayed++
+			return replayEntry(output, bridgeClient, entry)
+		})
+		if err != nil {
+			return numReplayed, err
+		}
+	}
+
+	return numReplayed, nil
+}
+
+func replayEntry(output io.Writer, bridgeClient client.ClientHandler, entry *journal.Entry) error {
+	bridgeOps, err := entry.ToBridgeOperations()
+	if err != nil {
+		return fmt.Errorf("%w, idempotency key: %s", err, entry.IdempotencyKey)
+	}
+
+	if bridgeClient == nil {
+		_, _ = fmt.Fprintf(output, "%s: %d bridge data\n", entry.IdempotencyKey, len(bridgeOps.Data))
+		return nil
+	}
+
+	sendCtx := idempotency.AppendToOutgoingContext(context.Background(), entry.IdempotencyKey)
+	res, err := bridgeClient.Send(sendCtx, bridgeOps)
+	if err != nil {
+		return fmt.Errorf("%w, idempotency key: %s", err, entry.IdempotencyKey)
+	}
+
+	for _, txHash := range res.TxHashes {
+		_, _ = fmt.Fprintln(output, txHash)
+	}
+
+	return nil
+}
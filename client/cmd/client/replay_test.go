@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/journal"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+// writeRotatedJournal journals one request per file, so the journal ends up with the current file and two backups
+func writeRotatedJournal(t *testing.T, hashes ...string) string {
+	path := filepath.Join(t.TempDir(), "journal")
+	writer, err := journal.NewRotatingWriter(journal.ArgsRotatingWriter{
+		Path:               path,
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		MaxFileSizeInBytes: 1,
+		MaxBackupFiles:     len(hashes),
+	})
+	require.Nil(t, err)
+
+	for _, hash := range hashes {
+		require.Nil(t, writer.Write(createBridgeOps(hash)))
+	}
+	require.Nil(t, writer.Close())
+
+	return path
+}
+
+func TestExpandJournalFiles(t *testing.T) {
+	t.Parallel()
+
+	path := writeRotatedJournal(t, "1", "2", "3")
+	otherPath := filepath.Join(t.TempDir(), "other")
+
+	files := expandJournalFiles([]string{path, otherPath})
+	require.Equal(t, []string{path + ".2", path + ".1", path, otherPath}, files)
+}
+
+func TestReplayFiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("entries should be replayed in the order they were sent, with their idempotency keys", func(t *testing.T) {
+		path := writeRotatedJournal(t, "1", "2", "3")
+
+		sentHashes := make([]string, 0)
+		bridgeClient := &testscommon.ClientHandlerMock{
+			SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				expectedKey, err := idempotency.ComputeKey(&marshal.GogoProtoMarshalizer{}, data)
+				require.Nil(t, err)
+				require.Equal(t, expectedKey, idempotency.FromOutgoingContext(ctx))
+
+				sentHashes = append(sentHashes, string(data.Data[0].Hash))
+				return &sovereign.BridgeOperationsResponse{TxHashes: []string{"tx" + string(data.Data[0].Hash)}}, nil
+			},
+		}
+
+		output := bytes.NewBuffer(nil)
+		numReplayed, err := replayFiles(output, bridgeClient, expandJournalFiles([]string{path}))
+		require.Nil(t, err)
+		require.Equal(t, 3, numReplayed)
+		require.Equal(t, []string{"1", "2", "3"}, sentHashes)
+		require.Equal(t, "tx1\ntx2\ntx3\n", output.String())
+	})
+	t.Run("dry run should only print the entries", func(t *testing.T) {
+		path := writeRotatedJournal(t, "1", "2")
+
+		output := bytes.NewBuffer(nil)
+		numReplayed, err := replayFiles(output, nil, expandJournalFiles([]string{path}))
+		require.Nil(t, err)
+		require.Equal(t, 2, numReplayed)
+		require.Contains(t, output.String(), ": 1 bridge data\n")
+	})
+	t.Run("send error should stop the replay", func(t *testing.T) {
+		path := writeRotatedJournal(t, "1", "2")
+
+		expectedErr := errors.New("send error")
+		bridgeClient := &testscommon.ClientHandlerMock{
+			SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		numReplayed, err := replayFiles(bytes.NewBuffer(nil), bridgeClient, expandJournalFiles([]string{path}))
+		require.ErrorIs(t, err, expectedErr)
+		require.Contains(t, err.Error(), "idempotency key: ")
+		require.Equal(t, 1, numReplayed)
+	})
+	t.Run("invalid journal entry", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "journal")
+		err := os.WriteFile(path, []byte(`{"idempotencyKey":"key","bridgeOperations":{"data":[{"hash":"zz"}]}}`+"\n"), 0600)
+		require.Nil(t, err)
+
+		numReplayed, err := replayFiles(bytes.NewBuffer(nil), nil, []string{path})
+		require.ErrorContains(t, err, "invalid data[0].hash: invalid hex encoding")
+		require.Contains(t, err.Error(), "idempotency key: key")
+		require.Equal(t, 1, numReplayed)
+	})
+	t.Run("missing journal file", func(t *testing.T) {
+		numReplayed, err := replayFiles(bytes.NewBuffer(nil), nil, []string{filepath.Join(t.TempDir(), "missing")})
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Zero(t, numReplayed)
+	})
+}
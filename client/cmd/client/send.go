@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/journal"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+)
+
+const (
+	stdinPath   = "-"
+	formatJSON  = "json"
+	formatProto = "proto"
+)
+
+func sendOperations(ctx *cli.Context) error {
+	bridgeOpsList, err := readInputs(ctx.StringSlice(inputFiles.Name), ctx.String(inputFormat.Name))
+	if err != nil {
+		return err
+	}
+
+	hasher := ctx.String(hasherName.Name)
+	if len(hasher) != 0 {
+		err = computeHashOfHashes(hasher, bridgeOpsList)
+		if err != nil {
+			return err
+		}
+	}
+
+	bridgeClient, _, err := createBridgeClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeBridgeClient(bridgeClient)
+
+	journalWriter, err := createJournalWriter(ctx.String(journalOutput.Name))
+	if err != nil {
+		return err
+	}
+	if journalWriter != nil {
+		defer func() {
+			log.LogIfError(journalWriter.Close())
+		}()
+	}
+
+	return sendAll(ctx.App.Writer, bridgeClient, journalWriter, bridgeOpsList)
+}
+
+// sendAll sends the bridge operations requests in order, stopping at the first failed one. Sent requests are also
+// journaled, if a journal writer is provided.
+func sendAll(output io.Writer, bridgeClient client.ClientHandler, writer journalWriter, bridgeOpsList []*sovereign.BridgeOperations) error {
+	for idx, bridgeOps := range bridgeOpsList {
+		err := send(output, bridgeClient, bridgeOps)
+		if err != nil {
+			return fmt.Errorf("%w, request index: %d", err, idx)
+		}
+
+		if writer != nil {
+			log.LogIfError(writer.Write(bridgeOps))
+		}
+	}
+
+	return nil
+}
+
+func send(output io.Writer, bridgeClient client.ClientHandler, bridgeOps *sovereign.BridgeOperations) error {
+	res, err := bridgeClient.Send(context.Background(), bridgeOps)
+	if err != nil {
+		return err
+	}
+
+	for _, txHash := range res.TxHashes {
+		_, _ = fmt.Fprintln(output, txHash)
+	}
+
+	return nil
+}
+
+func readInputs(paths []string, format string) ([]*sovereign.BridgeOperations, error) {
+	if len(paths) == 0 {
+		paths = []string{stdinPath}
+	}
+
+	bridgeOpsList := make([]*sovereign.BridgeOperations, 0, len(paths))
+	for _, path := range paths {
+		bridgeOps, err := readInput(path, format)
+		if err != nil {
+			return nil, fmt.Errorf("%w, input: %s", err, path)
+		}
+
+		bridgeOpsList = append(bridgeOpsList, bridgeOps)
+	}
+
+	return bridgeOpsList, nil
+}
+
+func readInput(path string, format string) (*sovereign.BridgeOperations, error) {
+	var buff []byte
+	var err error
+	if path == stdinPath {
+		buff, err = io.ReadAll(os.Stdin)
+	} else {
+		buff, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch getInputFormat(path, format) {
+	case formatJSON:
+		return operations.UnmarshalJSON(buff)
+	case formatProto:
+		bridgeOps := &sovereign.BridgeOperations{}
+		err = (&marshal.GogoProtoMarshalizer{}).Unmarshal(bridgeOps, buff)
+		return bridgeOps, err
+	default:
+		return nil, fmt.Errorf("unknown input format: %s, acceptable: %s, %s", format, formatJSON, formatProto)
+	}
+}
+
+func getInputFormat(path string, format string) string {
+	if len(format) != 0 {
+		return format
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".pb", ".proto":
+		return formatProto
+	default:
+		return formatJSON
+	}
+}
+
+func computeHashOfHashes(hasherType string, bridgeOpsList []*sovereign.BridgeOperations) error {
+	hasher, err := factory.NewHasher(hasherType)
+	if err != nil {
+		return err
+	}
+
+	for _, bridgeOps := range bridgeOpsList {
+		operations.SetHashOfHashes(hasher, bridgeOps)
+	}
+
+	return nil
+}
+
+func createJournalWriter(path string) (journalWriter, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	return journal.NewWriter(path, &marshal.GogoProtoMarshalizer{})
+}
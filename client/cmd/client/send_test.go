@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/journal"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+func createBridgeOps(hash string) *sovereign.BridgeOperations {
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte(hash),
+				OutGoingOperations: []*sovereign.OutGoingOperation{
+					{Hash: []byte(hash + "Op"), Data: []byte("data")},
+				},
+			},
+		},
+	}
+}
+
+func writeInputFile(t *testing.T, name string, content []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.Nil(t, os.WriteFile(path, content, 0600))
+
+	return path
+}
+
+func TestReadInputs(t *testing.T) {
+	t.Parallel()
+
+	protoBuff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(createBridgeOps("proto"))
+	require.Nil(t, err)
+
+	t.Run("json and proto files should be read in order", func(t *testing.T) {
+		jsonPath := writeInputFile(t, "ops.json", []byte(`{"data":[{"hash":"6a736f6e"}]}`))
+		protoPath := writeInputFile(t, "ops.pb", protoBuff)
+
+		bridgeOpsList, err := readInputs([]string{protoPath, jsonPath}, "")
+		require.Nil(t, err)
+		require.Len(t, bridgeOpsList, 2)
+		require.Equal(t, createBridgeOps("proto"), bridgeOpsList[0])
+		require.Equal(t, []byte("json"), bridgeOpsList[1].Data[0].Hash)
+	})
+	t.Run("format should override the file extension", func(t *testing.T) {
+		path := writeInputFile(t, "ops.json", protoBuff)
+
+		bridgeOpsList, err := readInputs([]string{path}, formatProto)
+		require.Nil(t, err)
+		require.Equal(t, createBridgeOps("proto"), bridgeOpsList[0])
+	})
+	t.Run("missing file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing.json")
+
+		bridgeOpsList, err := readInputs([]string{path}, "")
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Contains(t, err.Error(), "input: "+path)
+		require.Nil(t, bridgeOpsList)
+	})
+	t.Run("unknown format", func(t *testing.T) {
+		path := writeInputFile(t, "ops.json", []byte(`{}`))
+
+		bridgeOpsList, err := readInputs([]string{path}, "xml")
+		require.ErrorContains(t, err, "unknown input format: xml")
+		require.Nil(t, bridgeOpsList)
+	})
+	t.Run("invalid json", func(t *testing.T) {
+		path := writeInputFile(t, "ops.json", []byte(`{"data":`))
+
+		bridgeOpsList, err := readInputs([]string{path}, "")
+		require.ErrorContains(t, err, "input: "+path)
+		require.Nil(t, bridgeOpsList)
+	})
+	t.Run("invalid hex field should report its path", func(t *testing.T) {
+		validPath := writeInputFile(t, "valid.json", []byte(`{"data":[{"hash":"01"}]}`))
+		invalidPath := writeInputFile(t, "invalid.json", []byte(`{"data":[{"hash":"01","outGoingOperations":[{"hash":"0x01"}]}]}`))
+
+		bridgeOpsList, err := readInputs([]string{validPath, invalidPath}, "")
+		validationErr := &bridgeErrors.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "data[0].outGoingOperations[0].hash", validationErr.Field)
+		require.Contains(t, err.Error(), "input: "+invalidPath)
+		require.Nil(t, bridgeOpsList)
+	})
+	t.Run("invalid proto", func(t *testing.T) {
+		path := writeInputFile(t, "ops.pb", []byte("not a proto message"))
+
+		bridgeOpsList, err := readInputs([]string{path}, "")
+		require.NotNil(t, err)
+		require.Nil(t, bridgeOpsList)
+	})
+}
+
+func TestGetInputFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path     string
+		format   string
+		expected string
+	}{
+		{path: stdinPath, expected: formatJSON},
+		{path: "ops.json", expected: formatJSON},
+		{path: "ops.PB", expected: formatProto},
+		{path: "ops.proto", expected: formatProto},
+		{path: "ops", expected: formatJSON},
+		{path: "ops.pb", format: formatJSON, expected: formatJSON},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, getInputFormat(tt.path, tt.format), tt.path)
+	}
+}
+
+func TestComputeHashOfHashes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown hasher", func(t *testing.T) {
+		err := computeHashOfHashes("md5", []*sovereign.BridgeOperations{createBridgeOps("hash")})
+		require.NotNil(t, err)
+	})
+	t.Run("should overwrite the bridge data hashes", func(t *testing.T) {
+		bridgeOps := createBridgeOps("hash")
+		err := computeHashOfHashes("sha256", []*sovereign.BridgeOperations{bridgeOps})
+		require.Nil(t, err)
+		require.Len(t, bridgeOps.Data[0].Hash, 32)
+	})
+}
+
+func TestSendAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requests should be sent and journaled in order", func(t *testing.T) {
+		sentHashes := make([]string, 0)
+		bridgeClient := &testscommon.ClientHandlerMock{
+			SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				sentHashes = append(sentHashes, string(data.Data[0].Hash))
+				return &sovereign.BridgeOperationsResponse{TxHashes: []string{"tx" + string(data.Data[0].Hash)}}, nil
+			},
+		}
+		journalPath := filepath.Join(t.TempDir(), "journal")
+		writer, err := journal.NewWriter(journalPath, &marshal.GogoProtoMarshalizer{})
+		require.Nil(t, err)
+
+		output := bytes.NewBuffer(nil)
+		err = sendAll(output, bridgeClient, writer, []*sovereign.BridgeOperations{createBridgeOps("1"), createBridgeOps("2")})
+		require.Nil(t, err)
+		require.Nil(t, writer.Close())
+		require.Equal(t, []string{"1", "2"}, sentHashes)
+		require.Equal(t, "tx1\ntx2\n", output.String())
+
+		journaled := make([]*sovereign.BridgeOperations, 0)
+		err = journal.ReadFile(journalPath, func(entry *journal.Entry) error {
+			bridgeOps, errConvert := entry.ToBridgeOperations()
+			journaled = append(journaled, bridgeOps)
+			return errConvert
+		})
+		require.Nil(t, err)
+		require.Equal(t, []*sovereign.BridgeOperations{createBridgeOps("1"), createBridgeOps("2")}, journaled)
+	})
+	t.Run("should stop at the first failed request", func(t *testing.T) {
+		expectedErr := errors.New("send error")
+		numSent := 0
+		bridgeClient := &testscommon.ClientHandlerMock{
+			SendCalled: func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				numSent++
+				if numSent == 2 {
+					return nil, expectedErr
+				}
+				return &sovereign.BridgeOperationsResponse{}, nil
+			},
+		}
+
+		bridgeOpsList := []*sovereign.BridgeOperations{createBridgeOps("1"), createBridgeOps("2"), createBridgeOps("3")}
+		err := sendAll(bytes.NewBuffer(nil), bridgeClient, nil, bridgeOpsList)
+		require.ErrorIs(t, err, expectedErr)
+		require.Contains(t, err.Error(), "request index: 1")
+		require.Equal(t, 2, numSent)
+	})
+}
@@ -2,10 +2,65 @@ package config
 
 import "github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
 
-// ClientConfig holds all grpc client's config
+// ClientConfig holds all grpc client's config. If Endpoints are provided, GRPCHost and GRPCPort are ignored.
+// Mode selects whether bridge operations are sent to the server (grpc, default), only recorded (record), or both (tee).
+// LoadBalancingPolicy is either pick_first (default) or round_robin, only round_robin skipping the endpoints reported
+// as not serving by their health service.
 type ClientConfig struct {
-	Enabled        bool
-	GRPCHost       string
-	GRPCPort       string
-	CertificateCfg cert.FileCfg
+	Enabled             bool
+	Mode                string
+	GRPCHost            string
+	GRPCPort            string
+	Endpoints           []EndpointConfig
+	LoadBalancingPolicy string
+	CertificateCfg      cert.FileCfg
+	ConnectionCfg       ConnectionConfig
+	RetryCfg            RetryConfig
+	BufferCfg           BufferConfig
+	RecordingCfg        RecordingConfig
+}
+
+// EndpointConfig holds the address of one bridge server
+type EndpointConfig struct {
+	Host string
+	Port string
+}
+
+// ConnectionConfig holds the config used to establish and keep alive the grpc connection. Zero values are replaced
+// with defaults. BackoffJitter should be in [0, 1] interval, unset using the default jitter and zero disabling it.
+type ConnectionConfig struct {
+	ConnectTimeoutInSec   int
+	MaxConnectAttempts    int
+	InitialBackoffInMs    int
+	MaxBackoffInMs        int
+	BackoffJitter         *float64
+	KeepAliveTimeInSec    int
+	KeepAliveTimeoutInSec int
+}
+
+// RetryConfig holds the config for retrying failed calls. Only calls failed with a retryable grpc code are retried.
+// Zero values are replaced with defaults.
+type RetryConfig struct {
+	CallTimeoutInMs    int
+	MaxRetries         int
+	InitialBackoffInMs int
+	MaxBackoffInMs     int
+}
+
+// BufferConfig holds the config of the on-disk buffer for bridge operations. If enabled, bridge operations are
+// persisted before being delivered to the server, so they are not lost while the server is unreachable. Bridge
+// operations rejected with a non retryable error are moved to the dead-letter directory inside Path.
+type BufferConfig struct {
+	Enabled            bool
+	Path               string
+	InitialBackoffInMs int
+	MaxBackoffInMs     int
+}
+
+// RecordingConfig holds the config of the journal file used by the record and tee modes. The file is rotated once it
+// reaches the max size, keeping at most MaxBackupFiles older files. A zero max size disables rotation.
+type RecordingConfig struct {
+	Path            string
+	MaxFileSizeInMB int
+	MaxBackupFiles  int
 }
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	grpcBackoff "google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/backoff"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+)
+
+const (
+	defaultConnectTimeoutInSec   = 10
+	defaultMaxConnectAttempts    = 5
+	defaultInitialBackoffInMs    = 500
+	defaultMaxBackoffInMs        = 30_000
+	defaultBackoffJitter         = 0.2
+	defaultKeepAliveTimeInSec    = 30
+	defaultKeepAliveTimeoutInSec = 10
+)
+
+func applyConnectionDefaults(cfg config.ConnectionConfig) config.ConnectionConfig {
+	if cfg.ConnectTimeoutInSec <= 0 {
+		cfg.ConnectTimeoutInSec = defaultConnectTimeoutInSec
+	}
+	if cfg.MaxConnectAttempts <= 0 {
+		cfg.MaxConnectAttempts = defaultMaxConnectAttempts
+	}
+	if cfg.InitialBackoffInMs <= 0 {
+		cfg.InitialBackoffInMs = defaultInitialBackoffInMs
+	}
+	if cfg.MaxBackoffInMs <= 0 {
+		cfg.MaxBackoffInMs = defaultMaxBackoffInMs
+	}
+	if cfg.BackoffJitter == nil {
+		jitter := defaultBackoffJitter
+		cfg.BackoffJitter = &jitter
+	}
+	if cfg.KeepAliveTimeInSec <= 0 {
+		cfg.KeepAliveTimeInSec = defaultKeepAliveTimeInSec
+	}
+	if cfg.KeepAliveTimeoutInSec <= 0 {
+		cfg.KeepAliveTimeoutInSec = defaultKeepAliveTimeoutInSec
+	}
+
+	return cfg
+}
+
+func createDialOptions(cfg config.ConnectionConfig, creds credentials.TransportCredentials) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                time.Second * time.Duration(cfg.KeepAliveTimeInSec),
+			Timeout:             time.Second * time.Duration(cfg.KeepAliveTimeoutInSec),
+			PermitWithoutStream: true,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: grpcBackoff.Config{
+				BaseDelay:  time.Millisecond * time.Duration(cfg.InitialBackoffInMs),
+				Multiplier: grpcBackoff.DefaultConfig.Multiplier,
+				Jitter:     *cfg.BackoffJitter,
+				MaxDelay:   time.Millisecond * time.Duration(cfg.MaxBackoffInMs),
+			},
+			MinConnectTimeout: time.Second * time.Duration(cfg.ConnectTimeoutInSec),
+		}),
+	}
+}
+
+// connect dials the target and blocks until the connection is ready. Each attempt is bounded by the connect timeout,
+// failed attempts are retried with exponential backoff until max attempts are reached or the context is done.
+func connect(ctx context.Context, target string, cfg config.ConnectionConfig, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	backoffHandler, err := backoff.NewExponentialBackoff(backoff.ArgsExponentialBackoff{
+		Initial: time.Millisecond * time.Duration(cfg.InitialBackoffInMs),
+		Max:     time.Millisecond * time.Duration(cfg.MaxBackoffInMs),
+		Jitter:  *cfg.BackoffJitter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		conn, errConnect := connectOnce(ctx, target, time.Second*time.Duration(cfg.ConnectTimeoutInSec), dialOpts...)
+		if errConnect == nil {
+			log.Info("connected to bridge server", "target", target, "attempts", attempt)
+			return conn, nil
+		}
+
+		lastErr = errConnect
+		log.Warn("could not establish connection",
+			"error", errConnect,
+			"target", target,
+			"attempt", attempt,
+			"max attempts", cfg.MaxConnectAttempts)
+
+		if attempt >= cfg.MaxConnectAttempts || !backoffHandler.Wait(ctx.Done()) {
+			return nil, &ConnectionTimeoutError{
+				Target:   target,
+				Attempts: attempt,
+				Err:      lastErr,
+			}
+		}
+	}
+}
+
+// dialLazily dials the target without waiting for the connection to be ready. The connection is established in the
+// background and re-established with the configured backoff whenever it is lost.
+func dialLazily(target string, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.Connect()
+	log.Info("connecting to bridge server in background, bridge operations are buffered until it is reachable", "target", target)
+
+	return conn, nil
+}
+
+func connectOnce(ctx context.Context, target string, timeout time.Duration, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err = waitUntilReady(attemptCtx, conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func waitUntilReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+
+		if !conn.WaitForStateChange(ctx, state) {
+			return ctx.Err()
+		}
+	}
+}
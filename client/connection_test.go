@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+)
+
+func createTestConnectionConfig() config.ConnectionConfig {
+	return applyConnectionDefaults(config.ConnectionConfig{
+		ConnectTimeoutInSec: 1,
+		MaxConnectAttempts:  2,
+		InitialBackoffInMs:  10,
+		MaxBackoffInMs:      20,
+	})
+}
+
+func TestApplyConnectionDefaults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unset jitter should use the default", func(t *testing.T) {
+		cfg := applyConnectionDefaults(config.ConnectionConfig{})
+		require.Equal(t, defaultBackoffJitter, *cfg.BackoffJitter)
+	})
+	t.Run("zero jitter should be kept", func(t *testing.T) {
+		jitter := 0.0
+		cfg := applyConnectionDefaults(config.ConnectionConfig{
+			BackoffJitter: &jitter,
+		})
+		require.Equal(t, 0.0, *cfg.BackoffJitter)
+	})
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unreachable server should return timeout error after max attempts", func(t *testing.T) {
+		listener := bufconn.Listen(1024)
+		require.Nil(t, listener.Close())
+
+		cfg := createTestConnectionConfig()
+		dialOpts := append(createDialOptions(cfg, insecure.NewCredentials()), withBufConnDialer(listener))
+
+		conn, err := connect(context.Background(), "bufnet", cfg, dialOpts...)
+		require.Nil(t, conn)
+
+		timeoutErr := &ConnectionTimeoutError{}
+		require.ErrorAs(t, err, &timeoutErr)
+		require.Equal(t, "bufnet", timeoutErr.Target)
+		require.Equal(t, 2, timeoutErr.Attempts)
+	})
+	t.Run("done context should stop retrying", func(t *testing.T) {
+		listener := bufconn.Listen(1024)
+		require.Nil(t, listener.Close())
+
+		cfg := createTestConnectionConfig()
+		cfg.MaxConnectAttempts = 1000
+		dialOpts := append(createDialOptions(cfg, insecure.NewCredentials()), withBufConnDialer(listener))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+
+		conn, err := connect(ctx, "bufnet", cfg, dialOpts...)
+		require.Nil(t, conn)
+
+		timeoutErr := &ConnectionTimeoutError{}
+		require.ErrorAs(t, err, &timeoutErr)
+		require.Less(t, timeoutErr.Attempts, 1000)
+	})
+	t.Run("should connect when server is ready", func(t *testing.T) {
+		listener := bufconn.Listen(1024 * 1024)
+		grpcServer := grpc.NewServer()
+		go func() {
+			_ = grpcServer.Serve(listener)
+		}()
+		defer grpcServer.Stop()
+
+		cfg := createTestConnectionConfig()
+		dialOpts := append(createDialOptions(cfg, insecure.NewCredentials()), withBufConnDialer(listener))
+
+		conn, err := connect(context.Background(), "bufnet", cfg, dialOpts...)
+		require.Nil(t, err)
+		require.Nil(t, conn.Close())
+	})
+}
+
+func withBufConnDialer(listener *bufconn.Listener) grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/health" // registers the client side health checking
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+)
+
+const (
+	// PickFirstPolicy sends all requests to the first reachable endpoint, failing over to the next ones in order. It
+	// does not apply health checks, endpoints being skipped only once their connection fails.
+	PickFirstPolicy = "pick_first"
+	// RoundRobinPolicy spreads requests across all healthy endpoints
+	RoundRobinPolicy = "round_robin"
+
+	endpointsScheme = "sovereign-bridge"
+	endpointsTarget = endpointsScheme + ":///bridge-servers"
+
+	pickFirstServiceConfig = `{"loadBalancingConfig":[{"pick_first":{}}]}`
+	// health checking is done against the server's overall status
+	roundRobinServiceConfig = `{"loadBalancingConfig":[{"round_robin":{}}],"healthCheckConfig":{"serviceName":""}}`
+)
+
+func getEndpoints(cfg *config.ClientConfig) []config.EndpointConfig {
+	if len(cfg.Endpoints) != 0 {
+		return cfg.Endpoints
+	}
+
+	return []config.EndpointConfig{
+		{
+			Host: cfg.GRPCHost,
+			Port: cfg.GRPCPort,
+		},
+	}
+}
+
+func getLoadBalancingPolicy(policy string) (string, error) {
+	switch policy {
+	case "", PickFirstPolicy:
+		return PickFirstPolicy, nil
+	case RoundRobinPolicy:
+		return RoundRobinPolicy, nil
+	default:
+		return "", fmt.Errorf("%w: %s, acceptable: %s, %s", errInvalidLoadBalancingPolicy, policy, PickFirstPolicy, RoundRobinPolicy)
+	}
+}
+
+// createEndpointsDialOptions returns the dial target and options for a connection balanced across all the provided
+// endpoints. Each endpoint keeps its host as tls server name, so certificates are verified against each server.
+func createEndpointsDialOptions(endpoints []config.EndpointConfig, policy string) (string, []grpc.DialOption, error) {
+	if len(endpoints) == 0 {
+		return "", nil, errNoEndpoints
+	}
+
+	lbPolicy, err := getLoadBalancingPolicy(policy)
+	if err != nil {
+		return "", nil, err
+	}
+
+	addresses := make([]resolver.Address, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		addresses = append(addresses, resolver.Address{
+			Addr:       net.JoinHostPort(endpoint.Host, endpoint.Port),
+			ServerName: endpoint.Host,
+		})
+	}
+
+	endpointsResolver := manual.NewBuilderWithScheme(endpointsScheme)
+	endpointsResolver.InitialState(resolver.State{
+		Addresses: addresses,
+	})
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithResolvers(endpointsResolver),
+		grpc.WithDefaultServiceConfig(getServiceConfig(lbPolicy)),
+	}
+
+	return endpointsTarget, dialOpts, nil
+}
+
+// getServiceConfig sets the health check config only for round robin, as pick first would silently ignore it
+func getServiceConfig(lbPolicy string) string {
+	if lbPolicy == RoundRobinPolicy {
+		return roundRobinServiceConfig
+	}
+
+	return pickFirstServiceConfig
+}
+
+func endpointsToString(endpoints []config.EndpointConfig) string {
+	addresses := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		addresses = append(addresses, net.JoinHostPort(endpoint.Host, endpoint.Port))
+	}
+
+	return strings.Join(addresses, ",")
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+type testBridgeServer struct {
+	listener   *bufconn.Listener
+	grpcServer *grpc.Server
+	numCalls   atomic.Int32
+}
+
+func startTestBridgeServer(sendHandler func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error)) *testBridgeServer {
+	server := &testBridgeServer{
+		listener:   bufconn.Listen(1024 * 1024),
+		grpcServer: grpc.NewServer(),
+	}
+
+	sovereign.RegisterBridgeTxSenderServer(server.grpcServer, &testscommon.MockBridgeTxSenderServer{
+		SendCalled: func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			server.numCalls.Add(1)
+			if sendHandler != nil {
+				return sendHandler(ctx, req)
+			}
+
+			return &sovereign.BridgeOperationsResponse{}, nil
+		},
+	})
+	go func() {
+		_ = server.grpcServer.Serve(server.listener)
+	}()
+
+	return server
+}
+
+func connectToTestServers(t *testing.T, policy string, servers map[string]*testBridgeServer, endpoints []config.EndpointConfig) ClientHandler {
+	target, endpointsDialOpts, err := createEndpointsDialOptions(endpoints, policy)
+	require.Nil(t, err)
+
+	cfg := createTestConnectionConfig()
+	dialOpts := append(createDialOptions(cfg, insecure.NewCredentials()), endpointsDialOpts...)
+	dialOpts = append(dialOpts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return servers[addr].listener.DialContext(ctx)
+	}))
+
+	conn, err := connect(context.Background(), target, cfg, dialOpts...)
+	require.Nil(t, err)
+
+	c, _ := NewClient(sovereign.NewBridgeTxSenderClient(conn), conn)
+	return c
+}
+
+func TestGetLoadBalancingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := getLoadBalancingPolicy("")
+	require.Nil(t, err)
+	require.Equal(t, PickFirstPolicy, policy)
+
+	policy, err = getLoadBalancingPolicy(RoundRobinPolicy)
+	require.Nil(t, err)
+	require.Equal(t, RoundRobinPolicy, policy)
+
+	policy, err = getLoadBalancingPolicy("random")
+	require.ErrorIs(t, err, errInvalidLoadBalancingPolicy)
+	require.Empty(t, policy)
+}
+
+func TestGetServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	require.NotContains(t, getServiceConfig(PickFirstPolicy), "healthCheckConfig")
+	require.Contains(t, getServiceConfig(PickFirstPolicy), PickFirstPolicy)
+	require.Contains(t, getServiceConfig(RoundRobinPolicy), "healthCheckConfig")
+	require.Contains(t, getServiceConfig(RoundRobinPolicy), RoundRobinPolicy)
+}
+
+func TestGetEndpoints(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.ClientConfig{
+		GRPCHost: "localhost",
+		GRPCPort: "8085",
+	}
+	require.Equal(t, []config.EndpointConfig{{Host: "localhost", Port: "8085"}}, getEndpoints(cfg))
+
+	cfg.Endpoints = []config.EndpointConfig{{Host: "host1", Port: "1"}, {Host: "host2", Port: "2"}}
+	require.Equal(t, cfg.Endpoints, getEndpoints(cfg))
+	require.Equal(t, "host1:1,host2:2", endpointsToString(cfg.Endpoints))
+
+	_, _, err := createEndpointsDialOptions(nil, PickFirstPolicy)
+	require.Equal(t, errNoEndpoints, err)
+}
+
+func TestMultipleEndpoints(t *testing.T) {
+	t.Parallel()
+
+	endpoints := []config.EndpointConfig{{Host: "server1", Port: "1"}, {Host: "server2", Port: "2"}}
+
+	t.Run("round robin should spread requests", func(t *testing.T) {
+		servers := map[string]*testBridgeServer{
+			"server1:1": startTestBridgeServer(nil),
+			"server2:2": startTestBridgeServer(nil),
+		}
+		defer func() {
+			for _, server := range servers {
+				server.grpcServer.Stop()
+			}
+		}()
+
+		c := connectToTestServers(t, RoundRobinPolicy, servers, endpoints)
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		require.Eventually(t, func() bool {
+			_, err := c.Send(context.Background(), &sovereign.BridgeOperations{})
+			require.Nil(t, err)
+
+			return servers["server1:1"].numCalls.Load() > 0 && servers["server2:2"].numCalls.Load() > 0
+		}, time.Second*5, time.Millisecond)
+	})
+	t.Run("pick first should fail over when active server goes away", func(t *testing.T) {
+		servers := map[string]*testBridgeServer{
+			"server1:1": startTestBridgeServer(nil),
+			"server2:2": startTestBridgeServer(nil),
+		}
+		defer servers["server2:2"].grpcServer.Stop()
+
+		c := connectToTestServers(t, PickFirstPolicy, servers, endpoints)
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		_, err := c.Send(context.Background(), &sovereign.BridgeOperations{})
+		require.Nil(t, err)
+		require.Equal(t, int32(1), servers["server1:1"].numCalls.Load())
+		require.Equal(t, int32(0), servers["server2:2"].numCalls.Load())
+
+		servers["server1:1"].grpcServer.Stop()
+		require.Nil(t, servers["server1:1"].listener.Close())
+
+		require.Eventually(t, func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+			defer cancel()
+
+			_, err = c.Send(ctx, &sovereign.BridgeOperations{})
+			return err == nil
+		}, time.Second*5, time.Millisecond*10)
+		require.Equal(t, int32(1), servers["server2:2"].numCalls.Load())
+	})
+}
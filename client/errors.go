@@ -1,5 +1,36 @@
 package client
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var errNilClientConnection = errors.New("nil grpc client connection provided")
+
+var errNilMarshaller = errors.New("nil marshaller provided")
+
+var errNoEndpoints = errors.New("no bridge server endpoints provided")
+
+var errInvalidLoadBalancingPolicy = errors.New("invalid load balancing policy")
+
+var errNilBufConnListener = errors.New("nil bufconn listener provided")
+
+var errInvalidClientMode = errors.New("invalid client mode")
+
+// ConnectionTimeoutError is returned when the connection to the bridge server could not be established within
+// the configured number of attempts or before the provided context was done
+type ConnectionTimeoutError struct {
+	Target   string
+	Attempts int
+	Err      error
+}
+
+// Error returns the error message
+func (e *ConnectionTimeoutError) Error() string {
+	return fmt.Sprintf("could not connect to %s after %d attempt(s): %v", e.Target, e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last connection attempt
+func (e *ConnectionTimeoutError) Unwrap() error {
+	return e.Err
+}
@@ -1,59 +1,141 @@
 package client
 
 import (
-	"fmt"
+	"context"
+	"io"
 	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/backoff"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/buffered"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/disabled"
 )
 
-const (
-	waitTime = 5
-)
+const bufferBackoffJitter = 0.2
 
 var log = logger.GetOrCreate("client")
 
 // CreateClient creates a grpc client with retries
 func CreateClient(cfg *config.ClientConfig) (ClientHandler, error) {
+	return CreateClientWithContext(context.Background(), cfg)
+}
+
+// CreateClientWithContext creates a grpc client, blocking until the connection is ready. Connection attempts are
+// stopped once the context is done or the configured max attempts are reached, returning a ConnectionTimeoutError.
+// If the buffer is enabled, the client is created without waiting for the connection, so that bridge operations are
+// buffered on disk while the server is unreachable, including at startup. On error, the already created connection
+// and clients are closed.
+func CreateClientWithContext(ctx context.Context, cfg *config.ClientConfig) (ClientHandler, error) {
 	if !cfg.Enabled {
 		return disabled.NewDisabledClient(), nil
 	}
 
-	dialTarget := fmt.Sprintf("%s:%s", cfg.GRPCHost, cfg.GRPCPort)
-	conn, err := connectWithRetries(dialTarget, cfg.CertificateCfg)
+	mode, err := getMode(cfg)
 	if err != nil {
 		return nil, err
 	}
+	if mode == RecordMode {
+		return createRecordingClient(cfg.RecordingCfg)
+	}
 
-	bridgeClient := sovereign.NewBridgeTxSenderClient(conn)
-	return NewClient(bridgeClient, conn)
-}
+	tlsConfig, err := cert.LoadTLSClientConfig(cfg.CertificateCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := getEndpoints(cfg)
+	dialTarget, endpointsDialOpts, err := createEndpointsDialOptions(endpoints, cfg.LoadBalancingPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("connecting to bridge servers", "endpoints", endpointsToString(endpoints), "policy", cfg.LoadBalancingPolicy)
 
-func connectWithRetries(host string, cfg cert.FileCfg) (GRPCConn, error) {
-	tlsConfig, err := cert.LoadTLSClientConfig(cfg)
+	retryInterceptor, err := NewRetryInterceptor(cfg.RetryCfg, &marshal.GogoProtoMarshalizer{})
 	if err != nil {
 		return nil, err
 	}
 
-	for i := 0; ; i++ {
-		tlsCredentials := credentials.NewTLS(tlsConfig)
-		cc, errConnection := grpc.Dial(host, grpc.WithTransportCredentials(tlsCredentials))
-		if errConnection == nil {
-			return cc, errConnection
-		}
+	connectionCfg := applyConnectionDefaults(cfg.ConnectionCfg)
+	dialOpts := append(createDialOptions(connectionCfg, credentials.NewTLS(tlsConfig)), endpointsDialOpts...)
+	dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(retryInterceptor))
+	conn, err := createConnection(ctx, dialTarget, connectionCfg, cfg.BufferCfg.Enabled, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
 
-		time.Sleep(time.Second * waitTime)
+	bridgeClient := sovereign.NewBridgeTxSenderClient(conn)
+	grpcClient, err := NewClient(bridgeClient, conn)
+	if err != nil {
+		return nil, closeOnError(conn, err)
+	}
 
-		log.Warn("could not establish connection, retrying",
-			"error", errConnection,
-			"host", host,
-			"retries", i+1)
+	clientHandler, err := createClientHandler(grpcClient, cfg)
+	if err != nil {
+		return nil, closeOnError(grpcClient, err)
 	}
+
+	if mode != TeeMode {
+		return clientHandler, nil
+	}
+
+	teeClient, err := createTeeClient(clientHandler, cfg.RecordingCfg)
+	if err != nil {
+		return nil, closeOnError(clientHandler, err)
+	}
+
+	return teeClient, nil
+}
+
+// closeOnError closes the resource created before the error, so that its connection and goroutines are released
+func closeOnError(closer io.Closer, err error) error {
+	log.LogIfError(closer.Close())
+	return err
+}
+
+func createConnection(
+	ctx context.Context,
+	target string,
+	cfg config.ConnectionConfig,
+	bufferEnabled bool,
+	dialOpts ...grpc.DialOption,
+) (*grpc.ClientConn, error) {
+	if bufferEnabled {
+		return dialLazily(target, dialOpts...)
+	}
+
+	return connect(ctx, target, cfg, dialOpts...)
+}
+
+func createClientHandler(grpcClient ClientHandler, cfg *config.ClientConfig) (ClientHandler, error) {
+	if !cfg.BufferCfg.Enabled {
+		return grpcClient, nil
+	}
+
+	return createBufferedClient(grpcClient, cfg.BufferCfg)
+}
+
+func createBufferedClient(grpcClient ClientHandler, cfg config.BufferConfig) (ClientHandler, error) {
+	backoffHandler, err := backoff.NewExponentialBackoff(backoff.ArgsExponentialBackoff{
+		Initial: time.Millisecond * time.Duration(cfg.InitialBackoffInMs),
+		Max:     time.Millisecond * time.Duration(cfg.MaxBackoffInMs),
+		Jitter:  bufferBackoffJitter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buffered.NewBufferedClient(buffered.ArgsBufferedClient{
+		Client:     grpcClient,
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Backoff:    backoffHandler,
+		Path:       cfg.Path,
+	})
 }
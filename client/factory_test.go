@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+)
+
+func createUnreachableServerConfig(t *testing.T) *config.ClientConfig {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.Nil(t, err)
+	require.Nil(t, listener.Close())
+
+	dir := t.TempDir()
+	certificateCfg := cert.FileCfg{
+		CertFile: filepath.Join(dir, "certificate.crt"),
+		PkFile:   filepath.Join(dir, "private_key.pem"),
+	}
+	err = cert.GenerateCertFiles(cert.CertificateCfg{
+		CertCfg: cert.CertCfg{
+			Organization: "test",
+			DNSName:      "localhost",
+			IPAddress:    "127.0.0.1",
+			Availability: 1,
+		},
+		CertFileCfg: certificateCfg,
+	})
+	require.Nil(t, err)
+
+	return &config.ClientConfig{
+		Enabled:        true,
+		GRPCHost:       "127.0.0.1",
+		GRPCPort:       port,
+		CertificateCfg: certificateCfg,
+		ConnectionCfg: config.ConnectionConfig{
+			ConnectTimeoutInSec: 1,
+			MaxConnectAttempts:  1,
+			InitialBackoffInMs:  10,
+			MaxBackoffInMs:      20,
+		},
+	}
+}
+
+func TestCreateClientWithContext_UnreachableServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without buffer should return timeout error", func(t *testing.T) {
+		c, err := CreateClientWithContext(context.Background(), createUnreachableServerConfig(t))
+		require.Nil(t, c)
+
+		timeoutErr := &ConnectionTimeoutError{}
+		require.ErrorAs(t, err, &timeoutErr)
+	})
+	t.Run("with buffer should start and buffer bridge operations", func(t *testing.T) {
+		cfg := createUnreachableServerConfig(t)
+		cfg.BufferCfg = config.BufferConfig{
+			Enabled:            true,
+			Path:               filepath.Join(t.TempDir(), "buffer"),
+			InitialBackoffInMs: 10,
+			MaxBackoffInMs:     20,
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+		defer cancel()
+		c, err := CreateClientWithContext(ctx, cfg)
+		require.Nil(t, err)
+
+		res, err := c.Send(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{
+					Hash: []byte("hash"),
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Empty(t, res.TxHashes)
+		require.Nil(t, c.Close())
+
+		// not delivered operations should be kept on disk for the next start
+		entries, err := os.ReadDir(cfg.BufferCfg.Path)
+		require.Nil(t, err)
+		require.Len(t, entries, 1)
+	})
+	t.Run("tee mode with invalid recording path should error", func(t *testing.T) {
+		cfg := createUnreachableServerConfig(t)
+		cfg.Mode = TeeMode
+		cfg.RecordingCfg = config.RecordingConfig{
+			Path: filepath.Join(t.TempDir(), "missing", "recording"),
+		}
+		cfg.BufferCfg = config.BufferConfig{
+			Enabled:            true,
+			Path:               filepath.Join(t.TempDir(), "buffer"),
+			InitialBackoffInMs: 10,
+			MaxBackoffInMs:     20,
+		}
+
+		c, err := CreateClientWithContext(context.Background(), cfg)
+		require.NotNil(t, err)
+		require.Nil(t, c)
+	})
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	"google.golang.org/grpc"
@@ -19,3 +20,8 @@ type GRPCConn interface {
 	grpc.ClientConnInterface
 	Close() error
 }
+
+type retryBackoff interface {
+	Next() time.Duration
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,9 @@
+package journal
+
+import "errors"
+
+var errNilMarshaller = errors.New("nil marshaller provided")
+
+var errEmptyPath = errors.New("empty journal path provided")
+
+var errInvalidRotationConfig = errors.New("invalid journal rotation config")
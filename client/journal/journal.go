@@ -0,0 +1,258 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+)
+
+var log = logger.GetOrCreate("client/journal")
+
+const (
+	filePermissions = 0600
+	maxLineSize     = 64 * 1024 * 1024
+)
+
+// Entry is one journaled bridge operations request. A journal file contains one json encoded entry per line.
+type Entry struct {
+	Timestamp        int64                            `json:"timestamp"`
+	IdempotencyKey   string                           `json:"idempotencyKey"`
+	BridgeOperations *operations.BridgeOperationsJSON `json:"bridgeOperations"`
+}
+
+// ToBridgeOperations returns the journaled bridge operations
+func (e *Entry) ToBridgeOperations() (*sovereign.BridgeOperations, error) {
+	return operations.FromJSON(e.BridgeOperations)
+}
+
+// ArgsRotatingWriter holds args to create a new rotating journal writer
+type ArgsRotatingWriter struct {
+	Path               string
+	Marshaller         marshal.Marshalizer
+	MaxFileSizeInBytes int64
+	MaxBackupFiles     int
+}
+
+type writer struct {
+	mut            sync.Mutex
+	marshaller     marshal.Marshalizer
+	path           string
+	file           *os.File
+	fileSize       int64
+	maxFileSize    int64
+	maxBackupFiles int
+}
+
+// NewWriter creates a journal writer which appends entries to the provided file
+func NewWriter(path string, marshaller marshal.Marshalizer) (*writer, error) {
+	return NewRotatingWriter(ArgsRotatingWriter{
+		Path:       path,
+		Marshaller: marshaller,
+	})
+}
+
+// NewRotatingWriter creates a journal writer which appends entries to the provided file. Once the file would exceed
+// the max size, it is renamed to path.1, older backups being shifted to path.2 ... path.MaxBackupFiles and the oldest
+// one being removed. A zero max file size disables rotation.
+func NewRotatingWriter(args ArgsRotatingWriter) (*writer, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, errNilMarshaller
+	}
+	if len(args.Path) == 0 {
+		return nil, errEmptyPath
+	}
+	if args.MaxFileSizeInBytes < 0 || args.MaxBackupFiles < 0 {
+		return nil, fmt.Errorf("%w, max file size: %d, max backup files: %d",
+			errInvalidRotationConfig, args.MaxFileSizeInBytes, args.MaxBackupFiles)
+	}
+
+	w := &writer{
+		marshaller:     args.Marshaller,
+		path:           args.Path,
+		maxFileSize:    args.MaxFileSizeInBytes,
+		maxBackupFiles: args.MaxBackupFiles,
+	}
+
+	err := w.openFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *writer) openFile() error {
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	w.file = file
+	w.fileSize = info.Size()
+	return nil
+}
+
+// Write appends the bridge operations to the journal
+func (w *writer) Write(data *sovereign.BridgeOperations) error {
+	line, err := createLine(w.marshaller, data)
+	if err != nil {
+		return err
+	}
+
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if w.shouldRotate(len(line)) {
+		err = w.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.Write(line)
+	w.fileSize += int64(n)
+	return err
+}
+
+func (w *writer) shouldRotate(lineSize int) bool {
+	return w.maxFileSize > 0 && w.fileSize > 0 && w.fileSize+int64(lineSize) > w.maxFileSize
+}
+
+func (w *writer) rotate() error {
+	err := w.file.Close()
+	if err != nil {
+		return err
+	}
+
+	if w.maxBackupFiles == 0 {
+		err = os.Remove(w.path)
+		if err != nil {
+			return err
+		}
+
+		return w.openFile()
+	}
+
+	err = os.Remove(backupPath(w.path, w.maxBackupFiles))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for idx := w.maxBackupFiles - 1; idx > 0; idx-- {
+		err = os.Rename(backupPath(w.path, idx), backupPath(w.path, idx+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	err = os.Rename(w.path, backupPath(w.path, 1))
+	if err != nil {
+		return err
+	}
+
+	log.Debug("rotated journal file", "path", w.path)
+	return w.openFile()
+}
+
+func backupPath(path string, idx int) string {
+	return fmt.Sprintf("%s.%d", path, idx)
+}
+
+// ListFiles returns the journal file along with its existing backups, from the oldest one to the current file, which
+// is the order in which they should be replayed
+func ListFiles(path string) []string {
+	files := make([]string, 0)
+	for idx := 1; ; idx++ {
+		_, err := os.Stat(backupPath(path, idx))
+		if err != nil {
+			break
+		}
+
+		files = append([]string{backupPath(path, idx)}, files...)
+	}
+
+	return append(files, path)
+}
+
+func createLine(marshaller marshal.Marshalizer, data *sovereign.BridgeOperations) ([]byte, error) {
+	key, err := idempotency.ComputeKey(marshaller, data)
+	if err != nil {
+		return nil, err
+	}
+
+	line, err := json.Marshal(&Entry{
+		Timestamp:        time.Now().Unix(),
+		IdempotencyKey:   key,
+		BridgeOperations: operations.ToJSON(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(line, '\n'), nil
+}
+
+// Close closes the journal file
+func (w *writer) Close() error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	return w.file.Close()
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (w *writer) IsInterfaceNil() bool {
+	return w == nil
+}
+
+// ReadFile reads all entries from the journal file, in order, calling the handler for each one of them
+func ReadFile(path string, handler func(entry *Entry) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		entry := &Entry{}
+		err = json.Unmarshal(scanner.Bytes(), entry)
+		if err != nil {
+			return fmt.Errorf("%w, file: %s, line: %d", err, path, lineNumber)
+		}
+
+		err = handler(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
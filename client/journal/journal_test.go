@@ -0,0 +1,137 @@
+package journal
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+)
+
+func createBridgeOps(hash string) *sovereign.BridgeOperations {
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte(hash),
+				OutGoingOperations: []*sovereign.OutGoingOperation{
+					{
+						Hash: []byte(hash + "_op"),
+						Data: []byte("data"),
+					},
+				},
+				AggregatedSignature: []byte("aggregatedSig"),
+				LeaderSignature:     []byte("leaderSig"),
+			},
+		},
+	}
+}
+
+func TestNewWriter(t *testing.T) {
+	t.Parallel()
+
+	w, err := NewWriter(filepath.Join(t.TempDir(), "journal"), nil)
+	require.Equal(t, errNilMarshaller, err)
+	require.Nil(t, w)
+
+	w, err = NewWriter(filepath.Join(t.TempDir(), "journal"), &marshal.GogoProtoMarshalizer{})
+	require.Nil(t, err)
+	require.False(t, w.IsInterfaceNil())
+	require.Nil(t, w.Close())
+}
+
+func TestWriter_WriteAndReadFile(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	path := filepath.Join(t.TempDir(), "journal")
+	w, err := NewWriter(path, marshaller)
+	require.Nil(t, err)
+
+	written := []*sovereign.BridgeOperations{createBridgeOps("hash1"), createBridgeOps("hash2")}
+	for _, bridgeOps := range written {
+		require.Nil(t, w.Write(bridgeOps))
+	}
+	require.Nil(t, w.Close())
+
+	read := make([]*sovereign.BridgeOperations, 0)
+	err = ReadFile(path, func(entry *Entry) error {
+		bridgeOps, errConvert := entry.ToBridgeOperations()
+		require.Nil(t, errConvert)
+
+		expectedKey, errKey := idempotency.ComputeKey(marshaller, bridgeOps)
+		require.Nil(t, errKey)
+		require.Equal(t, expectedKey, entry.IdempotencyKey)
+
+		read = append(read, bridgeOps)
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, written, read)
+}
+
+func TestNewRotatingWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		w, err := NewRotatingWriter(ArgsRotatingWriter{
+			Marshaller: &marshal.GogoProtoMarshalizer{},
+		})
+		require.Equal(t, errEmptyPath, err)
+		require.Nil(t, w)
+	})
+	t.Run("invalid rotation config should error", func(t *testing.T) {
+		w, err := NewRotatingWriter(ArgsRotatingWriter{
+			Path:               filepath.Join(t.TempDir(), "journal"),
+			Marshaller:         &marshal.GogoProtoMarshalizer{},
+			MaxFileSizeInBytes: -1,
+		})
+		require.ErrorIs(t, err, errInvalidRotationConfig)
+		require.Nil(t, w)
+	})
+}
+
+func TestWriter_Rotate(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "journal")
+	w, err := NewRotatingWriter(ArgsRotatingWriter{
+		Path:               path,
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		MaxFileSizeInBytes: 1,
+		MaxBackupFiles:     2,
+	})
+	require.Nil(t, err)
+
+	// each entry exceeds the max size, so every write after the first one rotates the file
+	written := []*sovereign.BridgeOperations{
+		createBridgeOps("hash1"),
+		createBridgeOps("hash2"),
+		createBridgeOps("hash3"),
+		createBridgeOps("hash4"),
+	}
+	for _, bridgeOps := range written {
+		require.Nil(t, w.Write(bridgeOps))
+	}
+	require.Nil(t, w.Close())
+
+	files := ListFiles(path)
+	require.Equal(t, []string{path + ".2", path + ".1", path}, files)
+
+	read := make([]*sovereign.BridgeOperations, 0)
+	for _, file := range files {
+		err = ReadFile(file, func(entry *Entry) error {
+			bridgeOps, errConvert := entry.ToBridgeOperations()
+			require.Nil(t, errConvert)
+
+			read = append(read, bridgeOps)
+			return nil
+		})
+		require.Nil(t, err)
+	}
+
+	// oldest entry was dropped together with the oldest backup
+	require.Equal(t, written[1:], read)
+}
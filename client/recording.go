@@ -0,0 +1,66 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/journal"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/recording"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/tee"
+)
+
+const (
+	// GRPCMode sends all bridge operations to the bridge servers
+	GRPCMode = "grpc"
+	// RecordMode only records bridge operations in a journal file, without connecting to any server
+	RecordMode = "record"
+	// TeeMode sends all bridge operations to the bridge servers and records them at the same time
+	TeeMode = "tee"
+
+	bytesInMB = 1024 * 1024
+)
+
+func getMode(cfg *config.ClientConfig) (string, error) {
+	switch cfg.Mode {
+	case "", GRPCMode:
+		return GRPCMode, nil
+	case RecordMode, TeeMode:
+		return cfg.Mode, nil
+	default:
+		return "", fmt.Errorf("%w: %s, acceptable: %s, %s, %s", errInvalidClientMode, cfg.Mode, GRPCMode, RecordMode, TeeMode)
+	}
+}
+
+func createJournalWriter(cfg config.RecordingConfig) (recording.JournalWriter, error) {
+	return journal.NewRotatingWriter(journal.ArgsRotatingWriter{
+		Path:               cfg.Path,
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		MaxFileSizeInBytes: int64(cfg.MaxFileSizeInMB) * bytesInMB,
+		MaxBackupFiles:     cfg.MaxBackupFiles,
+	})
+}
+
+func createRecordingClient(cfg config.RecordingConfig) (ClientHandler, error) {
+	writer, err := createJournalWriter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("recording bridge operations", "path", cfg.Path)
+	return recording.NewRecordingClient(writer)
+}
+
+func createTeeClient(bridgeClient ClientHandler, cfg config.RecordingConfig) (ClientHandler, error) {
+	writer, err := createJournalWriter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("recording sent bridge operations", "path", cfg.Path)
+	return tee.NewTeeClient(tee.ArgsTeeClient{
+		Client: bridgeClient,
+		Writer: writer,
+	})
+}
@@ -0,0 +1,48 @@
+package recording
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+)
+
+type client struct {
+	writer JournalWriter
+}
+
+// NewRecordingClient creates a client which only records bridge operations, without sending them to any server.
+// Recorded operations can be replayed later with the client cmd.
+func NewRecordingClient(writer JournalWriter) (*client, error) {
+	if check.IfNil(writer) {
+		return nil, errNilJournalWriter
+	}
+
+	return &client{
+		writer: writer,
+	}, nil
+}
+
+// Send records the bridge operations and returns an empty bridge operations response
+func (c *client) Send(_ context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+	if data == nil {
+		return nil, errNilBridgeOperations
+	}
+
+	err := c.writer.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sovereign.BridgeOperationsResponse{}, nil
+}
+
+// Close closes the journal writer
+func (c *client) Close() error {
+	return c.writer.Close()
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (c *client) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,71 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+func TestNewRecordingClient(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewRecordingClient(nil)
+	require.Equal(t, errNilJournalWriter, err)
+	require.Nil(t, c)
+
+	c, err = NewRecordingClient(&testscommon.JournalWriterMock{})
+	require.Nil(t, err)
+	require.False(t, c.IsInterfaceNil())
+}
+
+func TestClient_Send(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil bridge operations should error", func(t *testing.T) {
+		c, _ := NewRecordingClient(&testscommon.JournalWriterMock{})
+		res, err := c.Send(context.Background(), nil)
+		require.Equal(t, errNilBridgeOperations, err)
+		require.Nil(t, res)
+	})
+	t.Run("write error should be returned", func(t *testing.T) {
+		errWrite := errors.New("write error")
+		c, _ := NewRecordingClient(&testscommon.JournalWriterMock{
+			WriteCalled: func(data *sovereign.BridgeOperations) error {
+				return errWrite
+			},
+		})
+		res, err := c.Send(context.Background(), &sovereign.BridgeOperations{})
+		require.Equal(t, errWrite, err)
+		require.Nil(t, res)
+	})
+	t.Run("should record", func(t *testing.T) {
+		data := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+		}
+
+		var recorded *sovereign.BridgeOperations
+		wasClosed := false
+		c, _ := NewRecordingClient(&testscommon.JournalWriterMock{
+			WriteCalled: func(data *sovereign.BridgeOperations) error {
+				recorded = data
+				return nil
+			},
+			CloseCalled: func() error {
+				wasClosed = true
+				return nil
+			},
+		})
+		res, err := c.Send(context.Background(), data)
+		require.Nil(t, err)
+		require.Equal(t, &sovereign.BridgeOperationsResponse{}, res)
+		require.Equal(t, data, recorded)
+
+		require.Nil(t, c.Close())
+		require.True(t, wasClosed)
+	})
+}
@@ -0,0 +1,7 @@
+package recording
+
+import "errors"
+
+var errNilJournalWriter = errors.New("nil journal writer provided")
+
+var errNilBridgeOperations = errors.New("nil bridge operations provided")
@@ -0,0 +1,10 @@
+package recording
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+
+// JournalWriter defines the writer used to record bridge operations in a replayable format
+type JournalWriter interface {
+	Write(data *sovereign.BridgeOperations) error
+	Close() error
+	IsInterfaceNil() bool
+}
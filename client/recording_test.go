@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/journal"
+)
+
+func TestCreateClient_Modes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid mode should error", func(t *testing.T) {
+		c, err := CreateClient(&config.ClientConfig{
+			Enabled: true,
+			Mode:    "invalid",
+		})
+		require.ErrorIs(t, err, errInvalidClientMode)
+		require.Nil(t, c)
+	})
+	t.Run("record mode should only record bridge operations", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "recording")
+		c, err := CreateClient(&config.ClientConfig{
+			Enabled: true,
+			Mode:    RecordMode,
+			RecordingCfg: config.RecordingConfig{
+				Path: path,
+			},
+		})
+		require.Nil(t, err)
+
+		data := &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{
+				{
+					Hash: []byte("hash"),
+					OutGoingOperations: []*sovereign.OutGoingOperation{
+						{
+							Hash: []byte("opHash"),
+							Data: []byte("opData"),
+						},
+					},
+				},
+			},
+		}
+		res, err := c.Send(context.Background(), data)
+		require.Nil(t, err)
+		require.Empty(t, res.TxHashes)
+		require.Nil(t, c.Close())
+
+		recorded := make([]*sovereign.BridgeOperations, 0)
+		err = journal.ReadFile(path, func(entry *journal.Entry) error {
+			bridgeOps, errConvert := entry.ToBridgeOperations()
+			recorded = append(recorded, bridgeOps)
+			return errConvert
+		})
+		require.Nil(t, err)
+		require.Equal(t, []*sovereign.BridgeOperations{data}, recorded)
+	})
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"google.golang.org/grpc"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/backoff"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+)
+
+const (
+	defaultCallTimeoutInMs         = 60_000
+	defaultMaxRetries              = 3
+	defaultRetryInitialBackoffInMs = 200
+	defaultRetryMaxBackoffInMs     = 5_000
+	retryBackoffJitter             = 0.2
+)
+
+func applyRetryDefaults(cfg config.RetryConfig) config.RetryConfig {
+	if cfg.CallTimeoutInMs <= 0 {
+		cfg.CallTimeoutInMs = defaultCallTimeoutInMs
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.InitialBackoffInMs <= 0 {
+		cfg.InitialBackoffInMs = defaultRetryInitialBackoffInMs
+	}
+	if cfg.MaxBackoffInMs <= 0 {
+		cfg.MaxBackoffInMs = defaultRetryMaxBackoffInMs
+	}
+
+	return cfg
+}
+
+// IsRetryableError returns true if the call failed with a transient grpc error, or if the server attached retry info
+// to the error, so the call might succeed if retried
+func IsRetryableError(err error) bool {
+	return bridgeErrors.IsRetryable(err)
+}
+
+type retryInterceptor struct {
+	cfg        config.RetryConfig
+	marshaller marshal.Marshalizer
+}
+
+// NewRetryInterceptor creates a unary client interceptor which bounds each call attempt with the configured timeout,
+// if the caller did not set a deadline, and retries calls failed with retryable errors using exponential backoff.
+// Each bridge operations request is sent with a stable idempotency key, the same for all of its attempts.
+func NewRetryInterceptor(cfg config.RetryConfig, marshaller marshal.Marshalizer) (grpc.UnaryClientInterceptor, error) {
+	if check.IfNil(marshaller) {
+		return nil, errNilMarshaller
+	}
+
+	ri := &retryInterceptor{
+		cfg:        applyRetryDefaults(cfg),
+		marshaller: marshaller,
+	}
+
+	return ri.intercept, nil
+}
+
+func (ri *retryInterceptor) intercept(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	ctx, err := ri.attachIdempotencyKey(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	backoffHandler, err := backoff.NewExponentialBackoff(backoff.ArgsExponentialBackoff{
+		Initial: time.Millisecond * time.Duration(ri.cfg.InitialBackoffInMs),
+		Max:     time.Millisecond * time.Duration(ri.cfg.MaxBackoffInMs),
+		Jitter:  retryBackoffJitter,
+	})
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = ri.invoke(ctx, method, req, reply, cc, invoker, opts...)
+		if err == nil || !IsRetryableError(err) || ctx.Err() != nil {
+			return err
+		}
+		if attempt >= ri.cfg.MaxRetries {
+			log.Warn("call failed, no retries left", "method", method, "error", err, "retries", attempt)
+			return err
+		}
+
+		log.Debug("call failed, retrying", "method", method, "error", err, "attempt", attempt+1)
+		if !waitBeforeRetry(ctx, backoffHandler, err) {
+			return err
+		}
+	}
+}
+
+// waitBeforeRetry waits for the next backoff delay, or for the retry delay suggested by the server, if longer
+func waitBeforeRetry(ctx context.Context, backoffHandler retryBackoff, err error) bool {
+	delay := backoffHandler.Next()
+	retryDelay, hasRetryInfo := bridgeErrors.RetryDelay(err)
+	if hasRetryInfo && retryDelay > delay {
+		delay = retryDelay
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (ri *retryInterceptor) invoke(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	_, hasDeadline := ctx.Deadline()
+	if hasDeadline {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, time.Millisecond*time.Duration(ri.cfg.CallTimeoutInMs))
+	defer cancel()
+
+	return invoker(callCtx, method, req, reply, cc, opts...)
+}
+
+func (ri *retryInterceptor) attachIdempotencyKey(ctx context.Context, req interface{}) (context.Context, error) {
+	bridgeOps, isBridgeOps := req.(*sovereign.BridgeOperations)
+	if !isBridgeOps || len(idempotency.FromOutgoingContext(ctx)) != 0 {
+		return ctx, nil
+	}
+
+	key, err := idempotency.ComputeKey(ri.marshaller, bridgeOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotency.AppendToOutgoingContext(ctx, key), nil
+}
@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+)
+
+func createTestRetryConfig() config.RetryConfig {
+	return config.RetryConfig{
+		CallTimeoutInMs:    100,
+		MaxRetries:         3,
+		InitialBackoffInMs: 1,
+		MaxBackoffInMs:     5,
+	}
+}
+
+func connectWithRetries(t *testing.T, server *testBridgeServer, cfg config.RetryConfig) ClientHandler {
+	retryInterceptor, err := NewRetryInterceptor(cfg, &marshal.GogoProtoMarshalizer{})
+	require.Nil(t, err)
+
+	connectionCfg := createTestConnectionConfig()
+	dialOpts := append(createDialOptions(connectionCfg, insecure.NewCredentials()),
+		withBufConnDialer(server.listener),
+		grpc.WithChainUnaryInterceptor(retryInterceptor),
+	)
+
+	conn, err := connect(context.Background(), "bufnet", connectionCfg, dialOpts...)
+	require.Nil(t, err)
+
+	c, _ := NewClient(sovereign.NewBridgeTxSenderClient(conn), conn)
+	return c
+}
+
+func TestNewRetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor, err := NewRetryInterceptor(config.RetryConfig{}, nil)
+	require.Equal(t, errNilMarshaller, err)
+	require.Nil(t, interceptor)
+
+	interceptor, err = NewRetryInterceptor(config.RetryConfig{}, &marshal.GogoProtoMarshalizer{})
+	require.Nil(t, err)
+	require.NotNil(t, interceptor)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsRetryableError(status.Error(codes.Unavailable, "")))
+	require.True(t, IsRetryableError(status.Error(codes.ResourceExhausted, "")))
+	require.True(t, IsRetryableError(status.Error(codes.DeadlineExceeded, "")))
+
+	require.False(t, IsRetryableError(nil))
+	require.False(t, IsRetryableError(status.Error(codes.InvalidArgument, "")))
+	require.False(t, IsRetryableError(status.Error(codes.Internal, "")))
+	require.False(t, IsRetryableError(status.Error(codes.Unknown, "")))
+
+	require.True(t, IsRetryableError(bridgeErrors.ToGRPCError(&bridgeErrors.NonceError{Err: errors.New("lower nonce in transaction")})))
+	require.False(t, IsRetryableError(bridgeErrors.ToGRPCError(&bridgeErrors.SCRejectedError{Err: errors.New("user error")})))
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+	expectedKey, _ := idempotency.ComputeKey(&marshal.GogoProtoMarshalizer{}, bridgeOps)
+	expectedResponse := &sovereign.BridgeOperationsResponse{TxHashes: []string{"txHash"}}
+
+	t.Run("retryable errors should be retried with the same idempotency key", func(t *testing.T) {
+		numCalls := 0
+		server := startTestBridgeServer(func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			numCalls++
+			require.Equal(t, expectedKey, idempotency.FromIncomingContext(ctx))
+			if numCalls < 3 {
+				return nil, status.Error(codes.Unavailable, "proxy unavailable")
+			}
+
+			return expectedResponse, nil
+		})
+		defer server.grpcServer.Stop()
+
+		c := connectWithRetries(t, server, createTestRetryConfig())
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		res, err := c.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, expectedResponse.TxHashes, res.TxHashes)
+		require.Equal(t, int32(3), server.numCalls.Load())
+	})
+	t.Run("permanent errors should not be retried", func(t *testing.T) {
+		server := startTestBridgeServer(func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, "invalid bridge operations")
+		})
+		defer server.grpcServer.Stop()
+
+		c := connectWithRetries(t, server, createTestRetryConfig())
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		res, err := c.Send(context.Background(), bridgeOps)
+		require.Nil(t, res)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, int32(1), server.numCalls.Load())
+	})
+	t.Run("errors with retry info should be retried after the suggested delay", func(t *testing.T) {
+		retryDelay := time.Millisecond * 100
+		numCalls := 0
+		server := startTestBridgeServer(func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			numCalls++
+			if numCalls < 2 {
+				return nil, bridgeErrors.ToGRPCError(&bridgeErrors.NonceError{
+					Err:        errors.New("lower nonce in transaction"),
+					RetryDelay: retryDelay,
+				})
+			}
+
+			return expectedResponse, nil
+		})
+		defer server.grpcServer.Stop()
+
+		c := connectWithRetries(t, server, createTestRetryConfig())
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		start := time.Now()
+		res, err := c.Send(context.Background(), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, expectedResponse.TxHashes, res.TxHashes)
+		require.Equal(t, int32(2), server.numCalls.Load())
+		require.GreaterOrEqual(t, time.Since(start), retryDelay)
+	})
+	t.Run("default call deadline should be applied and retried until max retries", func(t *testing.T) {
+		server := startTestBridgeServer(func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		defer server.grpcServer.Stop()
+
+		c := connectWithRetries(t, server, createTestRetryConfig())
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		res, err := c.Send(context.Background(), bridgeOps)
+		require.Nil(t, res)
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		require.Eventually(t, func() bool {
+			return server.numCalls.Load() == 4
+		}, time.Second, time.Millisecond)
+	})
+	t.Run("caller deadline should stop retrying", func(t *testing.T) {
+		server := startTestBridgeServer(func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			return nil, status.Error(codes.Unavailable, "proxy unavailable")
+		})
+		defer server.grpcServer.Stop()
+
+		cfg := createTestRetryConfig()
+		cfg.MaxRetries = 1000
+		cfg.InitialBackoffInMs = 10
+		cfg.MaxBackoffInMs = 10
+		c := connectWithRetries(t, server, cfg)
+		defer func() {
+			require.Nil(t, c.Close())
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+
+		res, err := c.Send(ctx, bridgeOps)
+		require.Nil(t, res)
+		require.Contains(t, []codes.Code{codes.Unavailable, codes.DeadlineExceeded}, status.Code(err))
+		require.Less(t, server.numCalls.Load(), int32(1000))
+	})
+}
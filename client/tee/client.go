@@ -0,0 +1,66 @@
+package tee
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+)
+
+var log = logger.GetOrCreate("client/tee")
+
+// ArgsTeeClient holds args to create a new tee client
+type ArgsTeeClient struct {
+	Client BridgeClient
+	Writer JournalWriter
+}
+
+type client struct {
+	bridgeClient BridgeClient
+	writer       JournalWriter
+}
+
+// NewTeeClient creates a client which forwards bridge operations to the server and records them at the same time
+func NewTeeClient(args ArgsTeeClient) (*client, error) {
+	if check.IfNil(args.Client) {
+		return nil, errNilBridgeClient
+	}
+	if check.IfNil(args.Writer) {
+		return nil, errNilJournalWriter
+	}
+
+	return &client{
+		bridgeClient: args.Client,
+		writer:       args.Writer,
+	}, nil
+}
+
+// Send records the bridge operations and forwards them to the server. Operations are recorded before being sent, so
+// that failed requests are also available for investigation. A recording failure never blocks the delivery.
+func (c *client) Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+	if data != nil {
+		err := c.writer.Write(data)
+		if err != nil {
+			log.Error("could not record bridge operations", "error", err)
+		}
+	}
+
+	return c.bridgeClient.Send(ctx, data)
+}
+
+// Close closes both the underlying client and the journal writer
+func (c *client) Close() error {
+	errClient := c.bridgeClient.Close()
+	errWriter := c.writer.Close()
+	if errClient != nil {
+		return errClient
+	}
+
+	return errWriter
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (c *client) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,132 @@
+package tee
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+func TestNewTeeClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client should error", func(t *testing.T) {
+		c, err := NewTeeClient(ArgsTeeClient{Writer: &testscommon.JournalWriterMock{}})
+		require.Equal(t, errNilBridgeClient, err)
+		require.Nil(t, c)
+	})
+	t.Run("nil writer should error", func(t *testing.T) {
+		c, err := NewTeeClient(ArgsTeeClient{Client: &testscommon.ClientHandlerMock{}})
+		require.Equal(t, errNilJournalWriter, err)
+		require.Nil(t, c)
+	})
+	t.Run("should work", func(t *testing.T) {
+		c, err := NewTeeClient(ArgsTeeClient{
+			Client: &testscommon.ClientHandlerMock{},
+			Writer: &testscommon.JournalWriterMock{},
+		})
+		require.Nil(t, err)
+		require.False(t, c.IsInterfaceNil())
+	})
+}
+
+func TestClient_Send(t *testing.T) {
+	t.Parallel()
+
+	data := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{{Hash: []byte("hash")}},
+	}
+	expectedRes := &sovereign.BridgeOperationsResponse{TxHashes: []string{"txHash"}}
+
+	t.Run("should record and forward", func(t *testing.T) {
+		calls := make([]string, 0)
+		c, _ := NewTeeClient(ArgsTeeClient{
+			Client: &testscommon.ClientHandlerMock{
+				SendCalled: func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+					require.Equal(t, data, req)
+					calls = append(calls, "send")
+					return expectedRes, nil
+				},
+			},
+			Writer: &testscommon.JournalWriterMock{
+				WriteCalled: func(req *sovereign.BridgeOperations) error {
+					require.Equal(t, data, req)
+					calls = append(calls, "record")
+					return nil
+				},
+			},
+		})
+
+		res, err := c.Send(context.Background(), data)
+		require.Nil(t, err)
+		require.Equal(t, expectedRes, res)
+		require.Equal(t, []string{"record", "send"}, calls)
+	})
+	t.Run("recording error should not block sending", func(t *testing.T) {
+		c, _ := NewTeeClient(ArgsTeeClient{
+			Client: &testscommon.ClientHandlerMock{
+				SendCalled: func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+					return expectedRes, nil
+				},
+			},
+			Writer: &testscommon.JournalWriterMock{
+				WriteCalled: func(req *sovereign.BridgeOperations) error {
+					return errors.New("write error")
+				},
+			},
+		})
+
+		res, err := c.Send(context.Background(), data)
+		require.Nil(t, err)
+		require.Equal(t, expectedRes, res)
+	})
+	t.Run("send error should be recorded and returned", func(t *testing.T) {
+		errSend := errors.New("send error")
+		wasRecorded := false
+		c, _ := NewTeeClient(ArgsTeeClient{
+			Client: &testscommon.ClientHandlerMock{
+				SendCalled: func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+					return nil, errSend
+				},
+			},
+			Writer: &testscommon.JournalWriterMock{
+				WriteCalled: func(req *sovereign.BridgeOperations) error {
+					wasRecorded = true
+					return nil
+				},
+			},
+		})
+
+		res, err := c.Send(context.Background(), data)
+		require.Equal(t, errSend, err)
+		require.Nil(t, res)
+		require.True(t, wasRecorded)
+	})
+}
+
+func TestClient_Close(t *testing.T) {
+	t.Parallel()
+
+	errClose := errors.New("close error")
+	writerClosed := false
+	c, _ := NewTeeClient(ArgsTeeClient{
+		Client: &testscommon.ClientHandlerMock{
+			CloseCalled: func() error {
+				return errClose
+			},
+		},
+		Writer: &testscommon.JournalWriterMock{
+			CloseCalled: func() error {
+				writerClosed = true
+				return nil
+			},
+		},
+	})
+
+	require.Equal(t, errClose, c.Close())
+	require.True(t, writerClosed)
+}
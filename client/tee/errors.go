@@ -0,0 +1,7 @@
+package tee
+
+import "errors"
+
+var errNilBridgeClient = errors.New("nil bridge client provided")
+
+var errNilJournalWriter = errors.New("nil journal writer provided")
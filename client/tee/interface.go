@@ -0,0 +1,21 @@
+package tee
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+)
+
+// BridgeClient defines the client used to forward bridge operations to the server
+type BridgeClient interface {
+	Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// JournalWriter defines the writer used to record bridge operations in a replayable format
+type JournalWriter interface {
+	Write(data *sovereign.BridgeOperations) error
+	Close() error
+	IsInterfaceNil() bool
+}
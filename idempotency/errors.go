@@ -0,0 +1,7 @@
+package idempotency
+
+import "errors"
+
+var errNilMarshaller = errors.New("nil marshaller provided")
+
+var errNilBridgeOperations = errors.New("nil bridge operations provided")
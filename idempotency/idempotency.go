@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the grpc metadata key which holds the idempotency key of a bridge operations request
+const MetadataKey = "x-idempotency-key"
+
+// ComputeKey computes a stable idempotency key for the provided bridge operations. The same bridge operations will
+// always have the same key, so that retries of the same request can be identified by the server.
+func ComputeKey(marshaller marshal.Marshalizer, data *sovereign.BridgeOperations) (string, error) {
+	if check.IfNil(marshaller) {
+		return "", errNilMarshaller
+	}
+	if data == nil {
+		return "", errNilBridgeOperations
+	}
+
+	buff, err := marshaller.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	return KeyFromBytes(buff), nil
+}
+
+// KeyFromBytes computes the idempotency key of already marshalled bridge operations
+func KeyFromBytes(buff []byte) string {
+	hash := sha256.Sum256(buff)
+	return hex.EncodeToString(hash[:])
+}
+
+// AppendToOutgoingContext attaches the idempotency key to the outgoing grpc request
+func AppendToOutgoingContext(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, key)
+}
+
+// FromOutgoingContext returns the idempotency key attached to an outgoing grpc request, if any
+func FromOutgoingContext(ctx context.Context) string {
+	md, found := metadata.FromOutgoingContext(ctx)
+	if !found {
+		return ""
+	}
+
+	return firstValue(md.Get(MetadataKey))
+}
+
+// FromIncomingContext returns the idempotency key received with a grpc request, if any
+func FromIncomingContext(ctx context.Context) string {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return ""
+	}
+
+	return firstValue(md.Get(MetadataKey))
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
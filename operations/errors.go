@@ -0,0 +1,5 @@
+package operations
+
+import "errors"
+
+var errNilBridgeOperations = errors.New("nil bridge operations provided")
@@ -0,0 +1,24 @@
+package operations
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+)
+
+// ComputeHashOfHashes computes the hash of all outgoing operations hashes, as expected by the bridge contracts to
+// register the operations
+func ComputeHashOfHashes(hasher hashing.Hasher, outGoingOperations []*sovereign.OutGoingOperation) []byte {
+	hashes := make([]byte, 0)
+	for _, operation := range outGoingOperations {
+		hashes = append(hashes, operation.Hash...)
+	}
+
+	return hasher.Compute(string(hashes))
+}
+
+// SetHashOfHashes overwrites the hash of each bridge data with the computed hash of its operations hashes
+func SetHashOfHashes(hasher hashing.Hasher, data *sovereign.BridgeOperations) {
+	for _, bridgeData := range data.GetData() {
+		bridgeData.Hash = ComputeHashOfHashes(hasher, bridgeData.OutGoingOperations)
+	}
+}
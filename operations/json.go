@@ -0,0 +1,182 @@
+package operations
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+)
+
+// BridgeOperationsJSON is the json representation of bridge operations, with all byte fields hex encoded
+type BridgeOperationsJSON struct {
+	Data []*BridgeOutGoingDataJSON `json:"data"`
+}
+
+// BridgeOutGoingDataJSON is the json representation of one outgoing bridge data
+type BridgeOutGoingDataJSON struct {
+	Type                int32                    `json:"type,omitempty"`
+	Hash                string                   `json:"hash"`
+	OutGoingOperations  []*OutGoingOperationJSON `json:"outGoingOperations"`
+	AggregatedSignature string                   `json:"aggregatedSignature"`
+	LeaderSignature     string                   `json:"leaderSignature"`
+	PubKeysBitmap       string                   `json:"pubKeysBitmap,omitempty"`
+	Epoch               uint32                   `json:"epoch,omitempty"`
+}
+
+// OutGoingOperationJSON is the json representation of one outgoing operation
+type OutGoingOperationJSON struct {
+	Hash string `json:"hash"`
+	Data string `json:"data"`
+}
+
+// ToJSON converts bridge operations to their json representation. Nil bridge data and operations are kept as null, so
+// that they are reported when decoded.
+func ToJSON(data *sovereign.BridgeOperations) *BridgeOperationsJSON {
+	res := &BridgeOperationsJSON{
+		Data: make([]*BridgeOutGoingDataJSON, 0, len(data.GetData())),
+	}
+
+	for _, bridgeData := range data.GetData() {
+		if bridgeData == nil {
+			res.Data = append(res.Data, nil)
+			continue
+		}
+
+		outGoingOperations := make([]*OutGoingOperationJSON, 0, len(bridgeData.OutGoingOperations))
+		for _, operation := range bridgeData.OutGoingOperations {
+			if operation == nil {
+				outGoingOperations = append(outGoingOperations, nil)
+				continue
+			}
+
+			outGoingOperations = append(outGoingOperations, &OutGoingOperationJSON{
+				Hash: hex.EncodeToString(operation.Hash),
+				Data: hex.EncodeToString(operation.Data),
+			})
+		}
+
+		res.Data = append(res.Data, &BridgeOutGoingDataJSON{
+			Type:                bridgeData.Type,
+			Hash:                hex.EncodeToString(bridgeData.Hash),
+			OutGoingOperations:  outGoingOperations,
+			AggregatedSignature: hex.EncodeToString(bridgeData.AggregatedSignature),
+			LeaderSignature:     hex.EncodeToString(bridgeData.LeaderSignature),
+			PubKeysBitmap:       hex.EncodeToString(bridgeData.PubKeysBitmap),
+			Epoch:               bridgeData.Epoch,
+		})
+	}
+
+	return res
+}
+
+// FromJSON converts the json representation back to bridge operations. Null entries and invalid hex fields are reported
+// as bridgeErrors.ValidationError, with the same field paths as the server bridge operations validator (e.g.:
+// data[0].outGoingOperations[1].hash).
+func FromJSON(data *BridgeOperationsJSON) (*sovereign.BridgeOperations, error) {
+	if data == nil {
+		return nil, errNilBridgeOperations
+	}
+
+	res := &sovereign.BridgeOperations{
+		Data: make([]*sovereign.BridgeOutGoingData, 0, len(data.Data)),
+	}
+
+	for idx, bridgeData := range data.Data {
+		field := fmt.Sprintf("data[%d]", idx)
+		if bridgeData == nil {
+			return nil, &bridgeErrors.ValidationError{Field: field, Description: "nil bridge data"}
+		}
+
+		converted, err := bridgeDataFromJSON(field, bridgeData)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Data = append(res.Data, converted)
+	}
+
+	return res, nil
+}
+
+func bridgeDataFromJSON(field string, bridgeData *BridgeOutGoingDataJSON) (*sovereign.BridgeOutGoingData, error) {
+	hash, err := decodeHexField(field+".hash", bridgeData.Hash)
+	if err != nil {
+		return nil, err
+	}
+	aggregatedSig, err := decodeHexField(field+".aggregatedSignature", bridgeData.AggregatedSignature)
+	if err != nil {
+		return nil, err
+	}
+	leaderSig, err := decodeHexField(field+".leaderSignature", bridgeData.LeaderSignature)
+	if err != nil {
+		return nil, err
+	}
+	pubKeysBitmap, err := decodeHexField(field+".pubKeysBitmap", bridgeData.PubKeysBitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	outGoingOperations := make([]*sovereign.OutGoingOperation, 0, len(bridgeData.OutGoingOperations))
+	for idx, operation := range bridgeData.OutGoingOperations {
+		operationField := fmt.Sprintf("%s.outGoingOperations[%d]", field, idx)
+		if operation == nil {
+			return nil, &bridgeErrors.ValidationError{Field: operationField, Description: "nil operation"}
+		}
+
+		opHash, errDecode := decodeHexField(operationField+".hash", operation.Hash)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		opData, errDecode := decodeHexField(operationField+".data", operation.Data)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		outGoingOperations = append(outGoingOperations, &sovereign.OutGoingOperation{
+			Hash: opHash,
+			Data: opData,
+		})
+	}
+
+	return &sovereign.BridgeOutGoingData{
+		Type:                bridgeData.Type,
+		Hash:                hash,
+		OutGoingOperations:  outGoingOperations,
+		AggregatedSignature: aggregatedSig,
+		LeaderSignature:     leaderSig,
+		PubKeysBitmap:       pubKeysBitmap,
+		Epoch:               bridgeData.Epoch,
+	}, nil
+}
+
+func decodeHexField(field string, value string) ([]byte, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, &bridgeErrors.ValidationError{Field: field, Description: "invalid hex encoding"}
+	}
+
+	return decoded, nil
+}
+
+// MarshalJSON marshals bridge operations in their hex encoded json representation
+func MarshalJSON(data *sovereign.BridgeOperations) ([]byte, error) {
+	return json.Marshal(ToJSON(data))
+}
+
+// UnmarshalJSON unmarshals bridge operations from their hex encoded json representation
+func UnmarshalJSON(buff []byte) (*sovereign.BridgeOperations, error) {
+	data := &BridgeOperationsJSON{}
+	err := json.Unmarshal(buff, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return FromJSON(data)
+}
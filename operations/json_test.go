@@ -0,0 +1,93 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+)
+
+func createBridgeOps() *sovereign.BridgeOperations {
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+				OutGoingOperations: []*sovereign.OutGoingOperation{
+					{
+						Hash: []byte("opHash1"),
+						Data: []byte("bridgeOp1"),
+					},
+					{
+						Hash: []byte("opHash2"),
+						Data: []byte("bridgeOp2"),
+					},
+				},
+				AggregatedSignature: []byte("aggregatedSig"),
+				LeaderSignature:     []byte("leaderSig"),
+				PubKeysBitmap:       []byte{0x7},
+				Epoch:               4,
+			},
+		},
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be hex encoded and unmarshal back", func(t *testing.T) {
+		bridgeOps := createBridgeOps()
+
+		buff, err := MarshalJSON(bridgeOps)
+		require.Nil(t, err)
+		require.Contains(t, string(buff), `"hash":"68617368"`)
+		require.Contains(t, string(buff), `"data":"6272696467654f7031"`)
+
+		unmarshalled, err := UnmarshalJSON(buff)
+		require.Nil(t, err)
+		require.Equal(t, bridgeOps, unmarshalled)
+	})
+	t.Run("invalid hex field should return the field path", func(t *testing.T) {
+		res, err := UnmarshalJSON([]byte(`{"data":[{"hash":"68"},{"outGoingOperations":[{"hash":"0x01"}]}]}`))
+		require.Equal(t, &bridgeErrors.ValidationError{
+			Field:       "data[1].outGoingOperations[0].hash",
+			Description: "invalid hex encoding",
+		}, err)
+		require.Nil(t, res)
+	})
+	t.Run("nil bridge data", func(t *testing.T) {
+		res, err := UnmarshalJSON([]byte(`{"data":[null]}`))
+		require.Equal(t, &bridgeErrors.ValidationError{Field: "data[0]", Description: "nil bridge data"}, err)
+		require.Nil(t, res)
+	})
+	t.Run("nil outgoing operation", func(t *testing.T) {
+		res, err := UnmarshalJSON([]byte(`{"data":[{"outGoingOperations":[null]}]}`))
+		require.Equal(t, &bridgeErrors.ValidationError{Field: "data[0].outGoingOperations[0]", Description: "nil operation"}, err)
+		require.Nil(t, res)
+	})
+	t.Run("nil entries should be encoded as null", func(t *testing.T) {
+		buff, err := MarshalJSON(&sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{nil, {OutGoingOperations: []*sovereign.OutGoingOperation{nil}}},
+		})
+		require.Nil(t, err)
+		require.Contains(t, string(buff), `"data":[null,`)
+		require.Contains(t, string(buff), `"outGoingOperations":[null]`)
+	})
+	t.Run("nil json", func(t *testing.T) {
+		res, err := FromJSON(nil)
+		require.Equal(t, errNilBridgeOperations, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestSetHashOfHashes(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.NewSha256()
+	bridgeOps := createBridgeOps()
+	SetHashOfHashes(hasher, bridgeOps)
+
+	require.Equal(t, hasher.Compute("opHash1opHash2"), bridgeOps.Data[0].Hash)
+}
@@ -0,0 +1,55 @@
+package requestID
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the grpc metadata key which holds the request id, sent by clients or returned by the server
+const MetadataKey = "x-request-id"
+
+const idSize = 16
+
+type contextKey struct{}
+
+// New generates a new random request id
+func New() string {
+	buff := make([]byte, idSize)
+	_, _ = rand.Read(buff)
+
+	return hex.EncodeToString(buff)
+}
+
+// NewContext returns a copy of the context which holds the request id
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request id held by the context, if any
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// AppendToOutgoingContext attaches the request id to the outgoing grpc request
+func AppendToOutgoingContext(ctx context.Context, id string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+}
+
+// FromIncomingContext returns the request id received with a grpc request, if any
+func FromIncomingContext(ctx context.Context) string {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return ""
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// auditEntry is one line of the admin audit log
+type auditEntry struct {
+	Timestamp time.Time   `json:"timestamp"`
+	Actor     string      `json:"actor"`
+	Action    string      `json:"action"`
+	Previous  interface{} `json:"previous,omitempty"`
+	Requested interface{} `json:"requested,omitempty"`
+	Applied   bool        `json:"applied"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// auditLog appends json lines to the audit file
+type auditLog struct {
+	mut  sync.Mutex
+	path string
+}
+
+func newAuditLog(path string) *auditLog {
+	return &auditLog{
+		path: path,
+	}
+}
+
+func (al *auditLog) record(entry auditEntry) error {
+	entry.Timestamp = time.Now()
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	al.mut.Lock()
+	defer al.mut.Unlock()
+
+	file, err := os.OpenFile(al.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(buff, '\n'))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+const (
+	updateSendingConfigAction = "updateSendingConfig"
+
+	headerVerifierSCAddressField = "headerVerifierSCAddress"
+	dcdtSafeSCAddressField       = "dcdtSafeSCAddress"
+	sendingConfigField           = "sendingConfig"
+)
+
+// ArgsConfigUpdater holds the arguments needed to create a config updater
+type ArgsConfigUpdater struct {
+	TxSender     SendingConfigHandler
+	Proxy        Proxy
+	AuditLogPath string
+}
+
+type configUpdater struct {
+	txSender SendingConfigHandler
+	proxy    Proxy
+	auditLog *auditLog
+	mut      sync.Mutex
+}
+
+// NewConfigUpdater creates the component which updates the bridge contracts and the gas settings of the tx sender at
+// runtime. All update requests, including the rejected ones, are recorded in the audit log file.
+func NewConfigUpdater(args ArgsConfigUpdater) (*configUpdater, error) {
+	err := checkConfigUpdaterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configUpdater{
+		txSender: args.TxSender,
+		proxy:    args.Proxy,
+		auditLog: newAuditLog(args.AuditLogPath),
+	}, nil
+}
+
+func checkConfigUpdaterArgs(args ArgsConfigUpdater) error {
+	if check.IfNil(args.TxSender) {
+		return errNilTxSender
+	}
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if len(args.AuditLogPath) == 0 {
+		return errNoAuditLogPath
+	}
+
+	return nil
+}
+
+// SendingConfig returns the sending config currently used by the tx sender
+func (cu *configUpdater) SendingConfig() txSender.SendingConfig {
+	return cu.txSender.SendingConfig()
+}
+
+// UpdateSendingConfig applies the json patch on the current sending config. Only the provided fields are changed. The
+// new config is checked, including that changed contracts are deployed, and recorded in the audit log before being
+// used by the tx sender, starting with the next batch of txs. Changes are not persisted across restarts.
+func (cu *configUpdater) UpdateSendingConfig(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+	cu.mut.Lock()
+	defer cu.mut.Unlock()
+
+	previous := cu.txSender.SendingConfig()
+	entry := auditEntry{
+		Actor:     actor,
+		Action:    updateSendingConfigAction,
+		Previous:  previous,
+		Requested: json.RawMessage(patch),
+	}
+	if !json.Valid(patch) {
+		// the audit log is made of json lines, so invalid json is recorded as a string
+		entry.Requested = string(patch)
+	}
+
+	updated, err := cu.createSendingConfig(ctx, previous, patch)
+	if err != nil {
+		entry.Error = err.Error()
+		cu.recordRejected(entry)
+		return previous, err
+	}
+
+	entry.Requested = updated
+	entry.Applied = true
+	err = cu.auditLog.record(entry)
+	if err != nil {
+		return previous, fmt.Errorf("could not record sending config update in the audit log: %w", err)
+	}
+
+	err = cu.txSender.SetSendingConfig(updated)
+	if err != nil {
+		return previous, err
+	}
+
+	log.Info("sending config updated", "actor", actor)
+	return updated, nil
+}
+
+func (cu *configUpdater) recordRejected(entry auditEntry) {
+	err := cu.auditLog.record(entry)
+	if err != nil {
+		log.Error("could not record rejected sending config update in the audit log", "error", err)
+	}
+}
+
+func (cu *configUpdater) createSendingConfig(ctx context.Context, current txSender.SendingConfig, patch []byte) (txSender.SendingConfig, error) {
+	updated := current
+	decoder := json.NewDecoder(bytes.NewReader(patch))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&updated)
+	if err != nil {
+		return current, &bridgeErrors.ValidationError{Field: sendingConfigField, Description: err.Error()}
+	}
+
+	err = updated.Check()
+	if err != nil {
+		return current, &bridgeErrors.ValidationError{Field: sendingConfigField, Description: err.Error()}
+	}
+
+	if updated.HeaderVerifierSCAddress != current.HeaderVerifierSCAddress {
+		err = cu.checkContract(ctx, headerVerifierSCAddressField, updated.HeaderVerifierSCAddress)
+		if err != nil {
+			return current, err
+		}
+	}
+	if updated.DcdtSafeSCAddress != current.DcdtSafeSCAddress {
+		err = cu.checkContract(ctx, dcdtSafeSCAddressField, updated.DcdtSafeSCAddress)
+		if err != nil {
+			return current, err
+		}
+	}
+
+	return updated, nil
+}
+
+// checkContract reports the address errors as validation errors of the field
+func (cu *configUpdater) checkContract(ctx context.Context, field string, bech32Address string) error {
+	err := chain.CheckContract(ctx, cu.proxy, bech32Address)
+	if chain.IsContractAddressError(err) {
+		return &bridgeErrors.ValidationError{Field: field, Description: err.Error()}
+	}
+	if err != nil {
+		return fmt.Errorf("%w, address %s", err, bech32Address)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (cu *configUpdater) IsInterfaceNil() bool {
+	return cu == nil
+}
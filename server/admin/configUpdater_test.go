@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/serverMocks"
+)
+
+const (
+	testHeaderVerifierSC = "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7"
+	testDcdtSafeSC       = "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpq2j2ext"
+	testWalletAddress    = "drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"
+)
+
+func createTestSendingConfig() txSender.SendingConfig {
+	return txSender.SendingConfig{
+		HeaderVerifierSCAddress: "drt1hv",
+		DcdtSafeSCAddress:       "drt1safe",
+		RegisterGas:             txSender.GasConfig{GasLimit: 1000, GasPriceMultiplier: 1},
+		ExecuteGas:              txSender.GasConfig{GasLimit: 2000, GasPriceMultiplier: 1.5},
+	}
+}
+
+func createConfigUpdaterArgs(t *testing.T, sendingConfig *txSender.SendingConfig) ArgsConfigUpdater {
+	return ArgsConfigUpdater{
+		TxSender: &serverMocks.SendingConfigHandlerMock{
+			SendingConfigCalled: func() txSender.SendingConfig {
+				return *sendingConfig
+			},
+			SetSendingConfigCalled: func(cfg txSender.SendingConfig) error {
+				*sendingConfig = cfg
+				return nil
+			},
+		},
+		Proxy: &testscommon.ProxyMock{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{CodeHash: []byte("codeHash")}, nil
+			},
+		},
+		AuditLogPath: filepath.Join(t.TempDir(), "audit.log"),
+	}
+}
+
+func readAuditEntries(t *testing.T, path string) []map[string]interface{} {
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	entries := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestNewConfigUpdater(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx sender", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		args.TxSender = nil
+
+		cu, err := NewConfigUpdater(args)
+		require.Equal(t, errNilTxSender, err)
+		require.Nil(t, cu)
+	})
+	t.Run("nil proxy", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		args.Proxy = nil
+
+		cu, err := NewConfigUpdater(args)
+		require.Equal(t, errNilProxy, err)
+		require.Nil(t, cu)
+	})
+	t.Run("no audit log path", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		args.AuditLogPath = ""
+
+		cu, err := NewConfigUpdater(args)
+		require.Equal(t, errNoAuditLogPath, err)
+		require.Nil(t, cu)
+	})
+	t.Run("should work", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		cu, err := NewConfigUpdater(createConfigUpdaterArgs(t, &cfg))
+		require.Nil(t, err)
+		require.False(t, cu.IsInterfaceNil())
+		require.Equal(t, cfg, cu.SendingConfig())
+	})
+}
+
+func TestConfigUpdater_UpdateSendingConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid patches should be rejected and audited", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		cu, _ := NewConfigUpdater(args)
+
+		invalidPatches := map[string]string{
+			"not json":           `{"registerGas":`,
+			"unknown field":      `{"gasLimit":10}`,
+			"invalid multiplier": `{"executeGas":{"gasPriceMultiplier":20}}`,
+			"empty address":      `{"dcdtSafeSCAddress":""}`,
+			"not bech32":         `{"dcdtSafeSCAddress":"safe"}`,
+			"not a contract":     `{"dcdtSafeSCAddress":"` + testWalletAddress + `"}`,
+		}
+		for name, patch := range invalidPatches {
+			updated, err := cu.UpdateSendingConfig(context.Background(), []byte(patch), "127.0.0.1")
+			validationErr := &bridgeErrors.ValidationError{}
+			require.True(t, errors.As(err, &validationErr), name)
+			require.Equal(t, createTestSendingConfig(), updated, name)
+		}
+		require.Equal(t, createTestSendingConfig(), cfg)
+
+		entries := readAuditEntries(t, args.AuditLogPath)
+		require.Len(t, entries, len(invalidPatches))
+		for _, entry := range entries {
+			require.Equal(t, "127.0.0.1", entry["actor"])
+			require.Equal(t, updateSendingConfigAction, entry["action"])
+			require.Equal(t, false, entry["applied"])
+			require.NotEmpty(t, entry["error"])
+		}
+	})
+	t.Run("contract which is not deployed should be rejected", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		args.Proxy = &testscommon.ProxyMock{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{}, nil
+			},
+		}
+		cu, _ := NewConfigUpdater(args)
+
+		_, err := cu.UpdateSendingConfig(context.Background(), []byte(`{"dcdtSafeSCAddress":"`+testDcdtSafeSC+`"}`), "admin")
+		require.Equal(t, &bridgeErrors.ValidationError{Field: dcdtSafeSCAddressField, Description: "no contract deployed at address"}, err)
+		require.Equal(t, createTestSendingConfig(), cfg)
+	})
+	t.Run("proxy error should be returned", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		errProxy := errors.New("proxy error")
+		args.Proxy = &testscommon.ProxyMock{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, errProxy
+			},
+		}
+		cu, _ := NewConfigUpdater(args)
+
+		_, err := cu.UpdateSendingConfig(context.Background(), []byte(`{"headerVerifierSCAddress":"`+testHeaderVerifierSC+`"}`), "admin")
+		require.ErrorIs(t, err, errProxy)
+		require.Equal(t, createTestSendingConfig(), cfg)
+	})
+	t.Run("update which can not be audited should not be applied", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		args.AuditLogPath = filepath.Join(t.TempDir(), "missing", "audit.log")
+		cu, _ := NewConfigUpdater(args)
+
+		_, err := cu.UpdateSendingConfig(context.Background(), []byte(`{"registerGas":{"gasLimit":3000}}`), "admin")
+		require.NotNil(t, err)
+		require.Equal(t, createTestSendingConfig(), cfg)
+	})
+	t.Run("should update only the provided fields", func(t *testing.T) {
+		cfg := createTestSendingConfig()
+		args := createConfigUpdaterArgs(t, &cfg)
+		checkedAddresses := make([]string, 0)
+		args.Proxy = &testscommon.ProxyMock{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				bech32Address, _ := address.AddressAsBech32String()
+				checkedAddresses = append(checkedAddresses, bech32Address)
+				return &data.Account{Code: "code"}, nil
+			},
+		}
+		cu, _ := NewConfigUpdater(args)
+
+		patch := `{"headerVerifierSCAddress":"` + testHeaderVerifierSC + `","registerGas":{"gasPriceMultiplier":2}}`
+		updated, err := cu.UpdateSendingConfig(context.Background(), []byte(patch), "admin")
+		require.Nil(t, err)
+
+		expectedCfg := createTestSendingConfig()
+		expectedCfg.HeaderVerifierSCAddress = testHeaderVerifierSC
+		expectedCfg.RegisterGas.GasPriceMultiplier = 2
+		require.Equal(t, expectedCfg, updated)
+		require.Equal(t, expectedCfg, cfg)
+		require.Equal(t, []string{testHeaderVerifierSC}, checkedAddresses)
+
+		entries := readAuditEntries(t, args.AuditLogPath)
+		require.Len(t, entries, 1)
+		require.Equal(t, "admin", entries[0]["actor"])
+		require.Equal(t, true, entries[0]["applied"])
+		require.Equal(t, "drt1hv", entries[0]["previous"].(map[string]interface{})[headerVerifierSCAddressField])
+		require.Equal(t, testHeaderVerifierSC, entries[0]["requested"].(map[string]interface{})[headerVerifierSCAddressField])
+	})
+}
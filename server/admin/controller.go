@@ -0,0 +1,276 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+)
+
+var log = logger.GetOrCreate("admin")
+
+const drainCheckInterval = time.Millisecond * 100
+
+// Status holds the sending state reported by the admin api
+type Status struct {
+	State            State     `json:"state"`
+	Mode             PauseMode `json:"mode,omitempty"`
+	PendingTxs       int       `json:"pendingTxs"`
+	InFlightRequests int       `json:"inFlightRequests"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// ArgsAdminController holds the arguments needed to create an admin controller
+type ArgsAdminController struct {
+	TxSender      TxSender
+	HealthMonitor HealthMonitor
+	StateFilePath string
+}
+
+type adminController struct {
+	txSender      TxSender
+	healthMonitor HealthMonitor
+	stateFilePath string
+	inFlight      atomic.Int64
+
+	mutState sync.RWMutex
+	state    persistedState
+	drain    *ongoingDrain
+}
+
+type ongoingDrain struct {
+	done   chan struct{}
+	cancel func()
+	// err is set before done is closed, it is nil if the drain completed
+	err error
+}
+
+// NewAdminController creates the controller which pauses, resumes and drains the bridge sender. It should be used as
+// the tx sender of the bridge server, so that received bridge operations are rejected while paused with the reject
+// mode or draining. The state is persisted in the state file and restored on creation.
+func NewAdminController(args ArgsAdminController) (*adminController, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := loadState(args.StateFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	ac := &adminController{
+		txSender:      args.TxSender,
+		healthMonitor: args.HealthMonitor,
+		stateFilePath: args.StateFilePath,
+		state:         state,
+	}
+	ac.applyState()
+
+	if state.State != StateRunning {
+		log.Warn("restored paused state, bridge operations are not sent until resumed", "mode", state.Mode)
+	}
+
+	return ac, nil
+}
+
+func checkArgs(args ArgsAdminController) error {
+	if check.IfNil(args.TxSender) {
+		return errNilTxSender
+	}
+	if check.IfNil(args.HealthMonitor) {
+		return errNilHealthMonitor
+	}
+	if len(args.StateFilePath) == 0 {
+		return errNoStateFilePath
+	}
+
+	return nil
+}
+
+// SendTxs forwards the bridge operations to the tx sender, unless they should be rejected in the current state
+func (ac *adminController) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+	// counted before checking the state, so that a drain waits for all accepted requests
+	ac.inFlight.Add(1)
+	defer ac.inFlight.Add(-1)
+
+	if !ac.isAcceptingOperations() {
+		return nil, &bridgeErrors.UnavailableError{Err: errSendingPaused}
+	}
+
+	return ac.txSender.SendTxs(ctx, data)
+}
+
+func (ac *adminController) isAcceptingOperations() bool {
+	ac.mutState.RLock()
+	defer ac.mutState.RUnlock()
+
+	return ac.state.State == StateRunning || ac.state.Mode == PauseModeQueue
+}
+
+// Pause stops sending txs. Bridge operations received while paused are queued or rejected, depending on the mode.
+func (ac *adminController) Pause(mode PauseMode) (Status, error) {
+	if !isValidPauseMode(mode) {
+		return ac.Status(), fmt.Errorf("%w: %s", ErrInvalidPauseMode, mode)
+	}
+
+	err := ac.setState(StatePaused, mode)
+	if err != nil {
+		return ac.Status(), err
+	}
+
+	log.Info("bridge sender paused", "mode", mode)
+	return ac.Status(), nil
+}
+
+// Resume starts sending txs again, including the ones queued while paused
+func (ac *adminController) Resume() (Status, error) {
+	err := ac.setState(StateRunning, "")
+	if err != nil {
+		return ac.Status(), err
+	}
+
+	log.Info("bridge sender resumed")
+	return ac.Status(), nil
+}
+
+// Drain rejects received bridge operations, while sending the in-flight ones, including the ones queued while paused.
+// Once all of them are sent, the bridge sender is paused with the reject mode. It blocks until drained, or until the
+// context is done, in which case the context error is returned and the drain continues in the background. If the drain
+// is stopped by a pause or resume, ErrDrainAborted is returned.
+func (ac *adminController) Drain(ctx context.Context) (Status, error) {
+	ac.mutState.Lock()
+	if ac.state.State != StateDraining {
+		err := ac.setStateUnprotected(StateDraining, PauseModeReject)
+		if err != nil {
+			ac.mutState.Unlock()
+			return ac.Status(), err
+		}
+
+		drainCtx, cancel := context.WithCancel(context.Background())
+		ac.drain = &ongoingDrain{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		go ac.waitUntilDrained(drainCtx)
+
+		log.Info("draining bridge sender")
+	}
+	drain := ac.drain
+	ac.mutState.Unlock()
+
+	select {
+	case <-drain.done:
+		return ac.Status(), drain.err
+	case <-ctx.Done():
+		return ac.Status(), ctx.Err()
+	}
+}
+
+func (ac *adminController) waitUntilDrained(ctx context.Context) {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if ac.inFlight.Load() != 0 || ac.txSender.Stats().PendingTxs != 0 {
+			continue
+		}
+
+		ac.mutState.Lock()
+		// the drain could have been stopped by a pause or resume in the meantime
+		if ctx.Err() == nil {
+			drainedState := newPersistedState(StatePaused, PauseModeReject)
+			err := saveState(ac.stateFilePath, drainedState)
+			if err != nil {
+				log.Error("could not persist drained state", "error", err)
+			}
+
+			// sending is paused even if the state could not be persisted
+			ac.stopDrain(nil)
+			ac.updateState(drainedState)
+			log.Info("bridge sender drained and paused")
+		}
+		ac.mutState.Unlock()
+
+		return
+	}
+}
+
+func (ac *adminController) setState(state State, mode PauseMode) error {
+	ac.mutState.Lock()
+	defer ac.mutState.Unlock()
+
+	return ac.setStateUnprotected(state, mode)
+}
+
+// setStateUnprotected persists and applies the state, stopping any ongoing drain. It should be called under mutex. The
+// state is not changed if it can not be persisted.
+func (ac *adminController) setStateUnprotected(state State, mode PauseMode) error {
+	newState := newPersistedState(state, mode)
+	err := saveState(ac.stateFilePath, newState)
+	if err != nil {
+		return fmt.Errorf("could not persist state: %w", err)
+	}
+
+	ac.stopDrain(fmt.Errorf("%w, new state: %s", ErrDrainAborted, state))
+	ac.updateState(newState)
+
+	return nil
+}
+
+// updateState should be called under mutex
+func (ac *adminController) updateState(state persistedState) {
+	ac.state = state
+	ac.applyState()
+}
+
+// stopDrain should be called under mutex. The drain error is returned to the callers waiting for the drain.
+func (ac *adminController) stopDrain(err error) {
+	if ac.drain == nil {
+		return
+	}
+
+	ac.drain.cancel()
+	ac.drain.err = err
+	close(ac.drain.done)
+	ac.drain = nil
+}
+
+// applyState should be called under mutex. Txs are only sent while running or draining, while the server is reported
+// as serving only while running.
+func (ac *adminController) applyState() {
+	ac.txSender.SetPaused(ac.state.State == StatePaused)
+	ac.healthMonitor.SetPaused(ac.state.State != StateRunning)
+}
+
+// Status returns the current sending state
+func (ac *adminController) Status() Status {
+	ac.mutState.RLock()
+	defer ac.mutState.RUnlock()
+
+	return Status{
+		State:            ac.state.State,
+		Mode:             ac.state.Mode,
+		PendingTxs:       ac.txSender.Stats().PendingTxs,
+		InFlightRequests: int(ac.inFlight.Load()),
+		UpdatedAt:        ac.state.UpdatedAt,
+	}
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ac *adminController) IsInterfaceNil() bool {
+	return ac == nil
+}
@@ -0,0 +1,296 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/serverMocks"
+)
+
+type pausedStates struct {
+	mut          sync.Mutex
+	txSender     bool
+	healthStatus bool
+}
+
+func (ps *pausedStates) get() (bool, bool) {
+	ps.mut.Lock()
+	defer ps.mut.Unlock()
+
+	return ps.txSender, ps.healthStatus
+}
+
+func createArgs(t *testing.T, paused *pausedStates) ArgsAdminController {
+	return ArgsAdminController{
+		TxSender: &serverMocks.TxSenderMock{
+			TxSenderMock: testscommon.TxSenderMock{
+				SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+					return []string{"txHash"}, nil
+				},
+			},
+			SetPausedCalled: func(isPaused bool) {
+				paused.mut.Lock()
+				paused.txSender = isPaused
+				paused.mut.Unlock()
+			},
+		},
+		HealthMonitor: &serverMocks.HealthMonitorMock{
+			SetPausedCalled: func(isPaused bool) {
+				paused.mut.Lock()
+				paused.healthStatus = isPaused
+				paused.mut.Unlock()
+			},
+		},
+		StateFilePath: filepath.Join(t.TempDir(), "state.json"),
+	}
+}
+
+func TestNewAdminController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx sender", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		args.TxSender = nil
+
+		ac, err := NewAdminController(args)
+		require.Equal(t, errNilTxSender, err)
+		require.Nil(t, ac)
+	})
+	t.Run("nil health monitor", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		args.HealthMonitor = nil
+
+		ac, err := NewAdminController(args)
+		require.Equal(t, errNilHealthMonitor, err)
+		require.Nil(t, ac)
+	})
+	t.Run("no state file path", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		args.StateFilePath = ""
+
+		ac, err := NewAdminController(args)
+		require.Equal(t, errNoStateFilePath, err)
+		require.Nil(t, ac)
+	})
+	t.Run("invalid persisted state", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		require.Nil(t, os.WriteFile(args.StateFilePath, []byte("paused"), 0644))
+
+		ac, err := NewAdminController(args)
+		require.ErrorIs(t, err, errInvalidPersistedState)
+		require.Nil(t, ac)
+	})
+	t.Run("should work", func(t *testing.T) {
+		paused := &pausedStates{txSender: true, healthStatus: true}
+		ac, err := NewAdminController(createArgs(t, paused))
+		require.Nil(t, err)
+		require.False(t, ac.IsInterfaceNil())
+		require.Equal(t, StateRunning, ac.Status().State)
+
+		txSenderPaused, healthPaused := paused.get()
+		require.False(t, txSenderPaused)
+		require.False(t, healthPaused)
+	})
+}
+
+func TestAdminController_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid pause mode", func(t *testing.T) {
+		ac, _ := NewAdminController(createArgs(t, &pausedStates{}))
+
+		st, err := ac.Pause("stop")
+		require.ErrorIs(t, err, ErrInvalidPauseMode)
+		require.Equal(t, StateRunning, st.State)
+	})
+	t.Run("state which can not be persisted should not change", func(t *testing.T) {
+		paused := &pausedStates{}
+		args := createArgs(t, paused)
+		args.StateFilePath = filepath.Join(t.TempDir(), "missing", "state.json")
+		ac, _ := NewAdminController(args)
+
+		st, err := ac.Pause(PauseModeReject)
+		require.NotNil(t, err)
+		require.Equal(t, StateRunning, st.State)
+
+		txSenderPaused, healthPaused := paused.get()
+		require.False(t, txSenderPaused)
+		require.False(t, healthPaused)
+	})
+	t.Run("reject mode should reject bridge operations", func(t *testing.T) {
+		paused := &pausedStates{}
+		ac, _ := NewAdminController(createArgs(t, paused))
+
+		st, err := ac.Pause(PauseModeReject)
+		require.Nil(t, err)
+		require.Equal(t, StatePaused, st.State)
+		require.Equal(t, PauseModeReject, st.Mode)
+
+		txSenderPaused, healthPaused := paused.get()
+		require.True(t, txSenderPaused)
+		require.True(t, healthPaused)
+
+		hashes, err := ac.SendTxs(context.Background(), &sovereign.BridgeOperations{})
+		require.ErrorIs(t, err, errSendingPaused)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Nil(t, hashes)
+
+		st, err = ac.Resume()
+		require.Nil(t, err)
+		require.Equal(t, StateRunning, st.State)
+		require.Empty(t, st.Mode)
+
+		txSenderPaused, healthPaused = paused.get()
+		require.False(t, txSenderPaused)
+		require.False(t, healthPaused)
+
+		hashes, err = ac.SendTxs(context.Background(), &sovereign.BridgeOperations{})
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash"}, hashes)
+	})
+	t.Run("queue mode should forward bridge operations to the paused tx sender", func(t *testing.T) {
+		paused := &pausedStates{}
+		ac, _ := NewAdminController(createArgs(t, paused))
+
+		_, err := ac.Pause(PauseModeQueue)
+		require.Nil(t, err)
+
+		txSenderPaused, _ := paused.get()
+		require.True(t, txSenderPaused)
+
+		hashes, err := ac.SendTxs(context.Background(), &sovereign.BridgeOperations{})
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash"}, hashes)
+	})
+	t.Run("state should persist across restarts", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		ac, _ := NewAdminController(args)
+
+		_, err := ac.Pause(PauseModeQueue)
+		require.Nil(t, err)
+
+		paused := &pausedStates{}
+		restartArgs := createArgs(t, paused)
+		restartArgs.StateFilePath = args.StateFilePath
+		ac, err = NewAdminController(restartArgs)
+		require.Nil(t, err)
+
+		st := ac.Status()
+		require.Equal(t, StatePaused, st.State)
+		require.Equal(t, PauseModeQueue, st.Mode)
+
+		txSenderPaused, healthPaused := paused.get()
+		require.True(t, txSenderPaused)
+		require.True(t, healthPaused)
+	})
+}
+
+func TestAdminController_Drain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pause once pending txs are sent", func(t *testing.T) {
+		pendingTxs := atomic.Int64{}
+		pendingTxs.Store(3)
+
+		paused := &pausedStates{txSender: true}
+		args := createArgs(t, paused)
+		args.TxSender.(*serverMocks.TxSenderMock).StatsCalled = func() txSender.Stats {
+			return txSender.Stats{PendingTxs: int(pendingTxs.Load())}
+		}
+		ac, _ := NewAdminController(args)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*300)
+		defer cancel()
+		st, err := ac.Drain(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+		require.Equal(t, StateDraining, st.State)
+		require.Equal(t, 3, st.PendingTxs)
+
+		txSenderPaused, healthPaused := paused.get()
+		require.False(t, txSenderPaused)
+		require.True(t, healthPaused)
+
+		hashes, err := ac.SendTxs(context.Background(), &sovereign.BridgeOperations{})
+		require.ErrorIs(t, err, errSendingPaused)
+		require.Nil(t, hashes)
+
+		pendingTxs.Store(0)
+		st, err = ac.Drain(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, StatePaused, st.State)
+		require.Equal(t, PauseModeReject, st.Mode)
+
+		txSenderPaused, healthPaused = paused.get()
+		require.True(t, txSenderPaused)
+		require.True(t, healthPaused)
+
+		state, err := loadState(args.StateFilePath)
+		require.Nil(t, err)
+		require.Equal(t, StatePaused, state.State)
+	})
+	t.Run("should wait for in-flight requests", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		sendStarted := make(chan struct{})
+		releaseSend := make(chan struct{})
+		args.TxSender.(*serverMocks.TxSenderMock).SendTxsCalled = func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+			close(sendStarted)
+			<-releaseSend
+			return nil, errors.New("send error")
+		}
+		ac, _ := NewAdminController(args)
+
+		go func() {
+			_, _ = ac.SendTxs(context.Background(), &sovereign.BridgeOperations{})
+		}()
+		<-sendStarted
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*300)
+		defer cancel()
+		st, err := ac.Drain(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+		require.Equal(t, 1, st.InFlightRequests)
+
+		close(releaseSend)
+		st, err = ac.Drain(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, StatePaused, st.State)
+		require.Equal(t, 0, st.InFlightRequests)
+	})
+	t.Run("resume should stop the drain", func(t *testing.T) {
+		args := createArgs(t, &pausedStates{})
+		args.TxSender.(*serverMocks.TxSenderMock).StatsCalled = func() txSender.Stats {
+			return txSender.Stats{PendingTxs: 1}
+		}
+		ac, _ := NewAdminController(args)
+
+		drained := make(chan Status)
+		drainErr := make(chan error, 1)
+		go func() {
+			st, err := ac.Drain(context.Background())
+			drainErr <- err
+			drained <- st
+		}()
+
+		require.Eventually(t, func() bool {
+			return ac.Status().State == StateDraining
+		}, time.Second, time.Millisecond*10)
+
+		_, err := ac.Resume()
+		require.Nil(t, err)
+		require.Equal(t, StateRunning, (<-drained).State)
+		require.ErrorIs(t, <-drainErr, ErrDrainAborted)
+	})
+}
@@ -0,0 +1,23 @@
+package admin
+
+import "errors"
+
+var errNilTxSender = errors.New("nil tx sender provided")
+
+var errNilHealthMonitor = errors.New("nil health monitor provided")
+
+var errNoStateFilePath = errors.New("no state file path provided")
+
+// ErrInvalidPauseMode signals that the requested pause mode is not known
+var ErrInvalidPauseMode = errors.New("invalid pause mode provided")
+
+// ErrDrainAborted signals that the drain was stopped by a pause or resume before all bridge operations were sent
+var ErrDrainAborted = errors.New("drain aborted")
+
+var errInvalidPersistedState = errors.New("invalid persisted state")
+
+var errSendingPaused = errors.New("sending bridge operations is paused")
+
+var errNilProxy = errors.New("nil proxy provided")
+
+var errNoAuditLogPath = errors.New("no audit log path provided")
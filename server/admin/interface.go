@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+// TxSender defines the tx sender which can be paused by the admin controller
+type TxSender interface {
+	SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error)
+	SetPaused(paused bool)
+	Stats() txSender.Stats
+	IsInterfaceNil() bool
+}
+
+// SendingConfigHandler defines the tx sender which can be reconfigured at runtime
+type SendingConfigHandler interface {
+	SendingConfig() txSender.SendingConfig
+	SetSendingConfig(cfg txSender.SendingConfig) error
+	IsInterfaceNil() bool
+}
+
+// Proxy defines the proxy used to check that the configured contracts are deployed
+type Proxy interface {
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
+
+// HealthMonitor defines the readiness monitor, which reports the server as not serving while paused
+type HealthMonitor interface {
+	SetPaused(paused bool)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,118 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State is the sending state of the bridge server
+type State string
+
+const (
+	// StateRunning means that bridge operations are received and sent
+	StateRunning State = "running"
+	// StatePaused means that no txs are sent. Received bridge operations are queued or rejected, depending on the mode
+	StatePaused State = "paused"
+	// StateDraining means that received bridge operations are rejected, while the in-flight ones are still sent. Once
+	// all of them are sent, the state becomes paused, with the reject mode.
+	StateDraining State = "draining"
+)
+
+// PauseMode defines how bridge operations received while paused are handled
+type PauseMode string
+
+const (
+	// PauseModeReject rejects received bridge operations as unavailable, so that they are retried by the clients
+	PauseModeReject PauseMode = "reject"
+	// PauseModeQueue queues received bridge operations, to be sent once resumed or drained
+	PauseModeQueue PauseMode = "queue"
+)
+
+type persistedState struct {
+	State     State     `json:"state"`
+	Mode      PauseMode `json:"mode,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func newPersistedState(state State, mode PauseMode) persistedState {
+	return persistedState{
+		State:     state,
+		Mode:      mode,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// loadState reads the state persisted in the provided file. A missing file means the server was never paused.
+func loadState(path string) (persistedState, error) {
+	buff, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return persistedState{State: StateRunning}, nil
+	}
+	if err != nil {
+		return persistedState{}, err
+	}
+
+	state := persistedState{}
+	err = json.Unmarshal(buff, &state)
+	if err != nil {
+		return persistedState{}, fmt.Errorf("%w: %v", errInvalidPersistedState, err)
+	}
+
+	switch {
+	case state.State == StateRunning:
+		state.Mode = ""
+	case state.State == StatePaused && isValidPauseMode(state.Mode):
+	case state.State == StateDraining:
+		// nothing is in flight after a restart, so the drain is complete
+		state.State = StatePaused
+		state.Mode = PauseModeReject
+	default:
+		return persistedState{}, fmt.Errorf("%w: state %s, mode %s", errInvalidPersistedState, state.State, state.Mode)
+	}
+
+	return state, nil
+}
+
+// saveState writes the state to a temporary file which replaces the provided one, so that a crash never leaves a
+// partially written state
+func saveState(path string, state persistedState) error {
+	buff, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	_, err = tmpFile.Write(buff)
+	if err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	err = tmpFile.Sync()
+	if err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	err = tmpFile.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func isValidPauseMode(mode PauseMode) bool {
+	return mode == PauseModeReject || mode == PauseModeQueue
+}
@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_SaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should be running", func(t *testing.T) {
+		state, err := loadState(filepath.Join(t.TempDir(), "state.json"))
+		require.Nil(t, err)
+		require.Equal(t, StateRunning, state.State)
+	})
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.json")
+		require.Nil(t, os.WriteFile(path, []byte("{"), 0644))
+
+		_, err := loadState(path)
+		require.ErrorIs(t, err, errInvalidPersistedState)
+
+		require.Nil(t, saveState(path, newPersistedState(StatePaused, "stop")))
+		_, err = loadState(path)
+		require.ErrorIs(t, err, errInvalidPersistedState)
+
+		require.Nil(t, saveState(path, newPersistedState("stopped", PauseModeReject)))
+		_, err = loadState(path)
+		require.ErrorIs(t, err, errInvalidPersistedState)
+	})
+	t.Run("paused state should be restored", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "state.json")
+		savedState := newPersistedState(StatePaused, PauseModeQueue)
+		require.Nil(t, saveState(path, savedState))
+
+		state, err := loadState(path)
+		require.Nil(t, err)
+		require.Equal(t, savedState.State, state.State)
+		require.Equal(t, savedState.Mode, state.Mode)
+		require.True(t, savedState.UpdatedAt.Equal(state.UpdatedAt))
+
+		entries, err := os.ReadDir(dir)
+		require.Nil(t, err)
+		require.Len(t, entries, 1)
+	})
+	t.Run("draining state should be restored as drained", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.json")
+		require.Nil(t, saveState(path, newPersistedState(StateDraining, PauseModeReject)))
+
+		state, err := loadState(path)
+		require.Nil(t, err)
+		require.Equal(t, StatePaused, state.State)
+		require.Equal(t, PauseModeReject, state.Mode)
+	})
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/admin"
+)
+
+const (
+	adminPathPrefix     = "/admin"
+	pauseModeQueryParam = "mode"
+	maxConfigPatchSize  = 1 << 16
+)
+
+// registerAdminRoutes registers the admin api, used to pause, resume and drain the bridge sender and to update its
+// sending config. All requests should send the admin token as bearer token. The admin api is not served if no token is
+// configured.
+//
+// Pause uses the reject mode unless the queue mode is requested. Drain blocks until all in-flight bridge operations are
+// sent, responding with accepted if the request is cancelled or times out meanwhile, in which case the drain goes on,
+// and with conflict if the drain is aborted by a pause or resume.
+// The sending config is updated with a json patch, holding only the changed fields. The credential used, along with
+// the peer address, is recorded as the actor of the change in the audit log, see adminActor.
+func registerAdminRoutes(router *gin.Engine, adminController AdminController, configUpdater SendingConfigUpdater, authToken string) {
+	if len(authToken) == 0 {
+		log.Warn("admin api is disabled, no admin auth token provided")
+		return
+	}
+
+	group := router.Group(adminPathPrefix, requireBearerToken([]byte(authToken)))
+
+	group.GET("/state", func(c *gin.Context) {
+		c.JSON(http.StatusOK, adminController.Status())
+	})
+
+	group.POST("/pause", func(c *gin.Context) {
+		mode := admin.PauseMode(c.DefaultQuery(pauseModeQueryParam, string(admin.PauseModeReject)))
+		st, err := adminController.Pause(mode)
+		writeAdminResponse(c, st, err)
+	})
+
+	group.POST("/resume", func(c *gin.Context) {
+		st, err := adminController.Resume()
+		writeAdminResponse(c, st, err)
+	})
+
+	group.POST("/drain", func(c *gin.Context) {
+		st, err := adminController.Drain(c.Request.Context())
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			c.JSON(http.StatusAccepted, st)
+			return
+		}
+
+		writeAdminResponse(c, st, err)
+	})
+
+	group.GET("/config", func(c *gin.Context) {
+		c.JSON(http.StatusOK, configUpdater.SendingConfig())
+	})
+
+	group.PATCH("/config", func(c *gin.Context) {
+		patch, err := io.ReadAll(io.LimitReader(c.Request.Body, maxConfigPatchSize))
+		if err != nil {
+			writeErrorResponse(c, &bridgeErrors.ValidationError{Field: "body", Description: err.Error()})
+			return
+		}
+
+		sendingConfig, err := configUpdater.UpdateSendingConfig(c.Request.Context(), patch, adminActor(c))
+		if err != nil {
+			log.Error("admin request failed", "path", c.FullPath(), "error", err)
+			writeErrorResponse(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, sendingConfig)
+	})
+}
+
+// adminActor identifies the admin request by its credential: the subject of the verified client certificate, if any,
+// otherwise the shared admin token. The address of the connection peer is appended, forwarding headers being ignored,
+// since they can be set by any client.
+func adminActor(c *gin.Context) string {
+	identity := "admin token"
+	if isClientAuthenticated(c.Request) {
+		identity = "client certificate " + c.Request.TLS.VerifiedChains[0][0].Subject.String()
+	}
+
+	return fmt.Sprintf("%s from %s", identity, c.RemoteIP())
+}
+
+func writeAdminResponse(c *gin.Context, st admin.Status, err error) {
+	if err == nil {
+		c.JSON(http.StatusOK, st)
+		return
+	}
+
+	log.Error("admin request failed", "path", c.FullPath(), "error", err)
+
+	code := codes.Internal
+	httpStatus := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, admin.ErrInvalidPauseMode):
+		code = codes.InvalidArgument
+		httpStatus = http.StatusBadRequest
+	case errors.Is(err, admin.ErrDrainAborted):
+		code = codes.Aborted
+		httpStatus = http.StatusConflict
+	}
+
+	c.JSON(httpStatus, &ErrorResponseJSON{
+		Code:    code.String(),
+		Message: err.Error(),
+	})
+}
+
+// requireBearerToken rejects the requests which do not send the provided token as bearer token
+func requireBearerToken(token []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !hasBearerToken(c.Request, token) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, &ErrorResponseJSON{
+				Code:    codes.Unauthenticated.String(),
+				Message: "admin auth token required",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
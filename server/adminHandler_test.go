@@ -0,0 +1,293 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/admin"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/adminMocks"
+)
+
+const testAdminToken = "admin-secret"
+
+func serveAdminRequest(t *testing.T, adminController AdminController, method string, path string, token string) *httptest.ResponseRecorder {
+	args := createArgsGinHandler()
+	args.Admin = adminController
+	args.AdminAuthToken = testAdminToken
+
+	handler, err := NewGinHandler(args)
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(method, path, nil)
+	if len(token) != 0 {
+		req.Header.Set(authorizationHeader, bearerPrefix+token)
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	return w
+}
+
+func serveConfigRequest(t *testing.T, configUpdater SendingConfigUpdater, method string, body string) *httptest.ResponseRecorder {
+	return serveConfigRequestWithTLS(t, configUpdater, method, body, nil)
+}
+
+func serveConfigRequestWithTLS(t *testing.T, configUpdater SendingConfigUpdater, method string, body string, tlsState *tls.ConnectionState) *httptest.ResponseRecorder {
+	args := createArgsGinHandler()
+	args.ConfigUpdater = configUpdater
+	args.AdminAuthToken = testAdminToken
+
+	handler, err := NewGinHandler(args)
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(method, "/admin/config", strings.NewReader(body))
+	req.Header.Set(authorizationHeader, bearerPrefix+testAdminToken)
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.TLS = tlsState
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	return w
+}
+
+func requireAdminStatus(t *testing.T, w *httptest.ResponseRecorder, expectedCode int, expectedStatus admin.Status) {
+	require.Equal(t, expectedCode, w.Code)
+
+	st := admin.Status{}
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &st))
+	require.Equal(t, expectedStatus, st)
+}
+
+func TestAdminRoutes(t *testing.T) {
+	t.Parallel()
+
+	pausedStatus := admin.Status{
+		State:      admin.StatePaused,
+		Mode:       admin.PauseModeReject,
+		PendingTxs: 2,
+	}
+
+	t.Run("no auth token should not serve the admin api", func(t *testing.T) {
+		args := createArgsGinHandler()
+		handler, err := NewGinHandler(args)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/state", nil)
+		req.Header.Set(authorizationHeader, bearerPrefix)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("unauthenticated requests should be rejected", func(t *testing.T) {
+		adminController := &adminMocks.AdminControllerMock{
+			PauseCalled: func(mode admin.PauseMode) (admin.Status, error) {
+				require.Fail(t, "should not pause")
+				return admin.Status{}, nil
+			},
+		}
+
+		w := serveAdminRequest(t, adminController, http.MethodPost, "/admin/pause", "")
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = serveAdminRequest(t, adminController, http.MethodPost, "/admin/pause", "wrong")
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, codes.Unauthenticated.String(), res.Code)
+	})
+	t.Run("state", func(t *testing.T) {
+		adminController := &adminMocks.AdminControllerMock{
+			StatusCalled: func() admin.Status {
+				return pausedStatus
+			},
+		}
+
+		w := serveAdminRequest(t, adminController, http.MethodGet, "/admin/state", testAdminToken)
+		requireAdminStatus(t, w, http.StatusOK, pausedStatus)
+	})
+	t.Run("pause should use the requested mode", func(t *testing.T) {
+		modes := make([]admin.PauseMode, 0)
+		adminController := &adminMocks.AdminControllerMock{
+			PauseCalled: func(mode admin.PauseMode) (admin.Status, error) {
+				modes = append(modes, mode)
+				return pausedStatus, nil
+			},
+		}
+
+		w := serveAdminRequest(t, adminController, http.MethodPost, "/admin/pause", testAdminToken)
+		requireAdminStatus(t, w, http.StatusOK, pausedStatus)
+
+		w = serveAdminRequest(t, adminController, http.MethodPost, "/admin/pause?mode=queue", testAdminToken)
+		requireAdminStatus(t, w, http.StatusOK, pausedStatus)
+
+		require.Equal(t, []admin.PauseMode{admin.PauseModeReject, admin.PauseModeQueue}, modes)
+	})
+	t.Run("pause errors", func(t *testing.T) {
+		adminController := &adminMocks.AdminControllerMock{
+			PauseCalled: func(mode admin.PauseMode) (admin.Status, error) {
+				if mode == "stop" {
+					return admin.Status{}, fmt.Errorf("%w: %s", admin.ErrInvalidPauseMode, mode)
+				}
+
+				return admin.Status{}, errors.New("could not persist state")
+			},
+		}
+
+		w := serveAdminRequest(t, adminController, http.MethodPost, "/admin/pause?mode=stop", testAdminToken)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, codes.InvalidArgument.String(), res.Code)
+
+		w = serveAdminRequest(t, adminController, http.MethodPost, "/admin/pause", testAdminToken)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("resume", func(t *testing.T) {
+		runningStatus := admin.Status{State: admin.StateRunning}
+		adminController := &adminMocks.AdminControllerMock{
+			ResumeCalled: func() (admin.Status, error) {
+				return runningStatus, nil
+			},
+		}
+
+		w := serveAdminRequest(t, adminController, http.MethodPost, "/admin/resume", testAdminToken)
+		requireAdminStatus(t, w, http.StatusOK, runningStatus)
+	})
+	t.Run("drain", func(t *testing.T) {
+		drainingStatus := admin.Status{State: admin.StateDraining, Mode: admin.PauseModeReject, PendingTxs: 2}
+		adminController := &adminMocks.AdminControllerMock{
+			DrainCalled: func(ctx context.Context) (admin.Status, error) {
+				return pausedStatus, nil
+			},
+		}
+
+		w := serveAdminRequest(t, adminController, http.MethodPost, "/admin/drain", testAdminToken)
+		requireAdminStatus(t, w, http.StatusOK, pausedStatus)
+
+		adminController.DrainCalled = func(ctx context.Context) (admin.Status, error) {
+			return drainingStatus, context.DeadlineExceeded
+		}
+		w = serveAdminRequest(t, adminController, http.MethodPost, "/admin/drain", testAdminToken)
+		requireAdminStatus(t, w, http.StatusAccepted, drainingStatus)
+
+		adminController.DrainCalled = func(ctx context.Context) (admin.Status, error) {
+			return admin.Status{State: admin.StateRunning}, fmt.Errorf("%w, new state: running", admin.ErrDrainAborted)
+		}
+		w = serveAdminRequest(t, adminController, http.MethodPost, "/admin/drain", testAdminToken)
+		require.Equal(t, http.StatusConflict, w.Code)
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, codes.Aborted.String(), res.Code)
+	})
+}
+
+func TestAdminConfigRoutes(t *testing.T) {
+	t.Parallel()
+
+	sendingConfig := txSender.SendingConfig{
+		HeaderVerifierSCAddress: "headerVerifier",
+		DcdtSafeSCAddress:       "dcdtSafe",
+		RegisterGas:             txSender.GasConfig{GasLimit: 1000, GasPriceMultiplier: 1},
+		ExecuteGas:              txSender.GasConfig{GasLimit: 2000, GasPriceMultiplier: 2},
+	}
+
+	t.Run("unauthenticated requests should be rejected", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.AdminAuthToken = testAdminToken
+		args.ConfigUpdater = &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+				require.Fail(t, "should not update the config")
+				return txSender.SendingConfig{}, nil
+			},
+		}
+		handler, err := NewGinHandler(args)
+		require.Nil(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, "/admin/config", strings.NewReader("{}"))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("get config", func(t *testing.T) {
+		configUpdater := &adminMocks.SendingConfigUpdaterMock{
+			SendingConfigCalled: func() txSender.SendingConfig {
+				return sendingConfig
+			},
+		}
+
+		w := serveConfigRequest(t, configUpdater, http.MethodGet, "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		res := txSender.SendingConfig{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, sendingConfig, res)
+	})
+	t.Run("update config", func(t *testing.T) {
+		patch := `{"executeGas":{"gasLimit":2000}}`
+		configUpdater := &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, receivedPatch []byte, actor string) (txSender.SendingConfig, error) {
+				require.Equal(t, patch, string(receivedPatch))
+				require.Equal(t, "admin token from 10.0.0.1", actor)
+				return sendingConfig, nil
+			},
+		}
+
+		w := serveConfigRequest(t, configUpdater, http.MethodPatch, patch)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		res := txSender.SendingConfig{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, sendingConfig, res)
+	})
+	t.Run("update config with client certificate should record its subject", func(t *testing.T) {
+		configUpdater := &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, receivedPatch []byte, actor string) (txSender.SendingConfig, error) {
+				require.Equal(t, "client certificate CN=operator,O=bridge from 10.0.0.1", actor)
+				return sendingConfig, nil
+			},
+		}
+		clientCertificate := &x509.Certificate{
+			Subject: pkix.Name{CommonName: "operator", Organization: []string{"bridge"}},
+		}
+		tlsState := &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{clientCertificate}},
+		}
+
+		w := serveConfigRequestWithTLS(t, configUpdater, http.MethodPatch, `{"executeGas":{"gasLimit":2000}}`, tlsState)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("invalid config should respond with bad request", func(t *testing.T) {
+		configUpdater := &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+				return sendingConfig, &bridgeErrors.ValidationError{Field: "dcdtSafeSCAddress", Description: "not a smart contract address"}
+			},
+		}
+
+		w := serveConfigRequest(t, configUpdater, http.MethodPatch, `{"dcdtSafeSCAddress":"drt1"}`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, codes.InvalidArgument.String(), res.Code)
+		require.Equal(t, []FieldViolationJSON{{Field: "dcdtSafeSCAddress", Description: "not a smart contract address"}}, res.FieldViolations)
+	})
+}
@@ -2,48 +2,78 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/requestID"
 )
 
 var log = logger.GetOrCreate("server")
 
 type server struct {
-	txSender TxSender
+	txSender     TxSender
+	validator    BridgeOperationsValidator
+	deduplicator *deduplicator
 	*sovereign.UnimplementedBridgeTxSenderServer
 }
 
 // NewSovereignBridgeTxServer creates a new sovereign bridge operations server. This server receives bridge data operations from
-// sovereign nodes and sends transactions to main chain.
-func NewSovereignBridgeTxServer(txSender TxSender) (*server, error) {
+// sovereign nodes and sends transactions to main chain. sendTimeout bounds the sending of requests with an idempotency
+// key, which are not canceled by their caller.
+func NewSovereignBridgeTxServer(txSender TxSender, validator BridgeOperationsValidator, sendTimeout time.Duration) (*server, error) {
 	if check.IfNil(txSender) {
 		return nil, errNilTxSender
 	}
+	if check.IfNil(validator) {
+		return nil, errNilValidator
+	}
+	if sendTimeout <= 0 {
+		return nil, errInvalidSendTimeout
+	}
 
 	return &server{
-		txSender: txSender,
+		txSender:     txSender,
+		validator:    validator,
+		deduplicator: newDeduplicator(idempotencyKeyTTL, sendTimeout),
 	}, nil
 }
 
-// Send should handle receiving data bridge operations from sovereign shard and forward transactions to main chain
+// Send should handle receiving data bridge operations from sovereign shard and forward transactions to main chain.
+// Requests retried with the same idempotency key are only sent once, receiving the tx hashes of the first request. These
+// are sent even if the caller stops waiting, up to the send timeout, so that a retry receives the result instead of
+// sending the txs again.
+// Invalid bridge operations are rejected before any tx is created. Errors are returned as grpc status errors, see
+// bridgeErrors.ToGRPCError.
 func (s *server) Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
-	hashes, err := s.txSender.SendTxs(ctx, data)
+	err := s.validator.Validate(data)
+	if err != nil {
+		log.Debug("received invalid bridge operations", "request id", requestID.FromContext(ctx), "error", err)
+		return nil, bridgeErrors.ToGRPCError(err)
+	}
+
+	hashes, err := s.deduplicator.do(ctx, idempotency.FromIncomingContext(ctx), func(sendCtx context.Context) ([]string, error) {
+		return s.txSender.SendTxs(sendCtx, data)
+	})
 	if err != nil {
-		return nil, err
+		log.Debug("could not send bridge operations", "request id", requestID.FromContext(ctx), "error", err)
+		return nil, bridgeErrors.ToGRPCError(err)
 	}
 
-	logTxHashes(hashes)
+	logTxHashes(requestID.FromContext(ctx), hashes)
 
 	return &sovereign.BridgeOperationsResponse{
 		TxHashes: hashes,
 	}, nil
 }
 
-func logTxHashes(hashes []string) {
+func logTxHashes(id string, hashes []string) {
 	for _, hash := range hashes {
-		log.Info("sent tx", "hash", hash)
+		log.Info("sent tx", "request id", id, "hash", hash)
 	}
 }
 
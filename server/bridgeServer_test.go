@@ -2,23 +2,43 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestNewSovereignBridgeTxServer(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil tx sender", func(t *testing.T) {
-		bridgeServer, err := NewSovereignBridgeTxServer(nil)
+		bridgeServer, err := NewSovereignBridgeTxServer(nil, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
 		require.Equal(t, errNilTxSender, err)
 		require.Nil(t, bridgeServer)
 	})
+	t.Run("nil validator", func(t *testing.T) {
+		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, nil, time.Minute)
+		require.Equal(t, errNilValidator, err)
+		require.Nil(t, bridgeServer)
+	})
+	t.Run("invalid send timeout", func(t *testing.T) {
+		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.BridgeOperationsValidatorMock{}, 0)
+		require.Equal(t, errInvalidSendTimeout, err)
+		require.Nil(t, bridgeServer)
+	})
 	t.Run("should work", func(t *testing.T) {
-		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{})
+		bridgeServer, err := NewSovereignBridgeTxServer(&testscommon.TxSenderMock{}, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
 		require.Nil(t, err)
 		require.False(t, bridgeServer.IsInterfaceNil())
 	})
@@ -42,10 +62,187 @@ func TestServer_Send(t *testing.T) {
 		},
 	}
 
-	bridgeServer, _ := NewSovereignBridgeTxServer(txSender)
+	bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
 	res, err := bridgeServer.Send(context.Background(), expectedBridgeOps)
 	require.Nil(t, err)
 	require.Equal(t, &sovereign.BridgeOperationsResponse{
 		TxHashes: expectedTxHashes,
 	}, res)
 }
+
+func TestServer_SendInvalidBridgeOperations(t *testing.T) {
+	t.Parallel()
+
+	validationErr := &bridgeErrors.ValidationError{Field: "data[0].hash", Description: "invalid hash length"}
+	txSender := &testscommon.TxSenderMock{
+		SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+			require.Fail(t, "invalid bridge operations should not be sent")
+			return nil, nil
+		},
+	}
+	validator := &testscommon.BridgeOperationsValidatorMock{
+		ValidateCalled: func(data *sovereign.BridgeOperations) error {
+			return validationErr
+		},
+	}
+
+	bridgeServer, _ := NewSovereignBridgeTxServer(txSender, validator, time.Minute)
+	res, err := bridgeServer.Send(context.Background(), &sovereign.BridgeOperations{})
+	require.Nil(t, res)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Len(t, bridgeErrors.FieldViolations(err), 1)
+}
+
+func TestServer_SendWithIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+	incomingCtx := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotency.MetadataKey, key))
+	}
+
+	t.Run("retried request should only be sent once", func(t *testing.T) {
+		numSendCalls := 0
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				numSendCalls++
+				return []string{fmt.Sprintf("txHash%d", numSendCalls)}, nil
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+		res1, err := bridgeServer.Send(incomingCtx("key1"), bridgeOps)
+		require.Nil(t, err)
+		res2, err := bridgeServer.Send(incomingCtx("key1"), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, res1, res2)
+		require.Equal(t, 1, numSendCalls)
+
+		res3, err := bridgeServer.Send(incomingCtx("key2"), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash2"}, res3.TxHashes)
+
+		_, _ = bridgeServer.Send(context.Background(), bridgeOps)
+		_, _ = bridgeServer.Send(context.Background(), bridgeOps)
+		require.Equal(t, 4, numSendCalls)
+	})
+	t.Run("failed request should be sent again", func(t *testing.T) {
+		expectedErr := errors.New("send error")
+		numSendCalls := 0
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				numSendCalls++
+				if numSendCalls == 1 {
+					return nil, expectedErr
+				}
+				return []string{"txHash"}, nil
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+		res, err := bridgeServer.Send(incomingCtx("key"), bridgeOps)
+		require.Equal(t, status.Error(codes.Internal, expectedErr.Error()), err)
+		require.Nil(t, res)
+
+		res, err = bridgeServer.Send(incomingCtx("key"), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash"}, res.TxHashes)
+		require.Equal(t, 2, numSendCalls)
+	})
+	t.Run("caller context error should not abort the send nor evict the key", func(t *testing.T) {
+		numSendCalls := atomic.Int32{}
+		release := make(chan struct{})
+		sendCtxErr := make(chan error, 1)
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				numSendCalls.Add(1)
+				<-release
+				sendCtxErr <- ctx.Err()
+				return []string{"txHash"}, nil
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+
+		ctx, cancel := context.WithTimeout(incomingCtx("key"), time.Millisecond*20)
+		defer cancel()
+		res, err := bridgeServer.Send(ctx, bridgeOps)
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		require.Nil(t, res)
+
+		// a retry waiting on its own context should not block until the first send is done
+		retryCtx, retryCancel := context.WithCancel(incomingCtx("key"))
+		retryCancel()
+		_, err = bridgeServer.Send(retryCtx, bridgeOps)
+		require.Equal(t, codes.Canceled, status.Code(err))
+
+		go func() {
+			time.Sleep(time.Millisecond * 20)
+			close(release)
+		}()
+		res, err = bridgeServer.Send(incomingCtx("key"), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash"}, res.TxHashes)
+		require.Nil(t, <-sendCtxErr)
+		require.Equal(t, int32(1), numSendCalls.Load())
+	})
+	t.Run("stuck send should be aborted on the send timeout", func(t *testing.T) {
+		numSendCalls := atomic.Int32{}
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				if numSendCalls.Add(1) == 1 {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}
+				return []string{"txHash"}, nil
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Millisecond*20)
+		res, err := bridgeServer.Send(incomingCtx("key"), bridgeOps)
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		require.Nil(t, res)
+
+		res, err = bridgeServer.Send(incomingCtx("key"), bridgeOps)
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash"}, res.TxHashes)
+		require.Equal(t, int32(2), numSendCalls.Load())
+	})
+	t.Run("concurrent requests with the same key should wait for the first one", func(t *testing.T) {
+		numSendCalls := atomic.Int32{}
+		release := make(chan struct{})
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				numSendCalls.Add(1)
+				<-release
+				return []string{"txHash"}, nil
+			},
+		}
+
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+
+		numRequests := 10
+		wg := sync.WaitGroup{}
+		wg.Add(numRequests)
+		for i := 0; i < numRequests; i++ {
+			go func() {
+				defer wg.Done()
+
+				res, err := bridgeServer.Send(incomingCtx("key"), bridgeOps)
+				require.Nil(t, err)
+				require.Equal(t, []string{"txHash"}, res.TxHashes)
+			}()
+		}
+
+		time.Sleep(time.Millisecond * 50)
+		close(release)
+		wg.Wait()
+		require.Equal(t, int32(1), numSendCalls.Load())
+	})
+}
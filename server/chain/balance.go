@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ParseBalance parses a denominated balance, which should not be negative
+func ParseBalance(value string) (*big.Int, error) {
+	balance, isValid := big.NewInt(0).SetString(value, 10)
+	if !isValid || balance.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidBalance, value)
+	}
+
+	return balance, nil
+}
+
+// ParseMinBalance parses a configured min balance, an empty value meaning no min balance
+func ParseMinBalance(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	return ParseBalance(value)
+}
+
+// CheckBalance parses the account balance and verifies that it is positive and not below the min balance. The
+// parsed balance is returned even if it is insufficient, so callers can still report it
+func CheckBalance(value string, minBalance *big.Int) (*big.Int, error) {
+	balance, err := ParseBalance(value)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Sign() <= 0 || balance.Cmp(minBalance) < 0 {
+		return balance, fmt.Errorf("%w: balance %s, min balance %s", ErrInsufficientBalance, balance.String(), minBalance.String())
+	}
+
+	return balance, nil
+}
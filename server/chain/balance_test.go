@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMinBalance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		expected      *big.Int
+		expectedError error
+	}{
+		{name: "empty value means no min balance", value: "", expected: big.NewInt(0)},
+		{name: "denominated value", value: "1000", expected: big.NewInt(1000)},
+		{name: "not a number", value: "one", expectedError: ErrInvalidBalance},
+		{name: "decimal value", value: "1.5", expectedError: ErrInvalidBalance},
+		{name: "negative value", value: "-5", expectedError: ErrInvalidBalance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minBalance, err := ParseMinBalance(tt.value)
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expected, minBalance)
+		})
+	}
+}
+
+func TestCheckBalance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		minBalance    *big.Int
+		expected      *big.Int
+		expectedError error
+	}{
+		{name: "invalid balance", value: "", minBalance: big.NewInt(0), expectedError: ErrInvalidBalance},
+		{name: "zero balance", value: "0", minBalance: big.NewInt(0), expected: big.NewInt(0), expectedError: ErrInsufficientBalance},
+		{name: "below min balance", value: "99", minBalance: big.NewInt(100), expected: big.NewInt(99), expectedError: ErrInsufficientBalance},
+		{name: "equal to min balance", value: "100", minBalance: big.NewInt(100), expected: big.NewInt(100)},
+		{name: "above min balance", value: "101", minBalance: big.NewInt(100), expected: big.NewInt(101)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balance, err := CheckBalance(tt.value, tt.minBalance)
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expected, balance)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	chainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// CheckContract verifies that the address is a valid bech32 contract address, with code deployed on chain.
+// Errors caused by the address itself can be told apart from the proxy ones with IsContractAddressError
+func CheckContract(ctx context.Context, proxy AccountProvider, bech32Address string) error {
+	if check.IfNil(proxy) {
+		return ErrNilProxy
+	}
+
+	address, err := data.NewAddressFromBech32String(bech32Address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidAddress, err)
+	}
+	if !chainCore.IsSmartContractAddress(address.AddressBytes()) {
+		return ErrNotSmartContract
+	}
+
+	account, err := proxy.GetAccount(ctx, address)
+	if err != nil {
+		return fmt.Errorf("could not get account: %w", err)
+	}
+	if len(account.Code) == 0 && len(account.CodeHash) == 0 {
+		return ErrNoContractDeployed
+	}
+
+	return nil
+}
+
+// IsContractAddressError returns true if the error returned by CheckContract is caused by the address itself
+func IsContractAddressError(err error) bool {
+	return errors.Is(err, ErrInvalidAddress) || errors.Is(err, ErrNotSmartContract) || errors.Is(err, ErrNoContractDeployed)
+}
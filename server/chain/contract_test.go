@@ -0,0 +1,61 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+const (
+	testContractAddress = "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7"
+	testWalletAddress   = "drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"
+)
+
+func createProxy(account *data.Account, err error) *testscommon.ProxyMock {
+	return &testscommon.ProxyMock{
+		GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			return account, err
+		},
+	}
+}
+
+func TestCheckContract(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy", func(t *testing.T) {
+		err := CheckContract(context.Background(), nil, testContractAddress)
+		require.Equal(t, ErrNilProxy, err)
+		require.False(t, IsContractAddressError(err))
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		err := CheckContract(context.Background(), createProxy(nil, nil), "address")
+		require.ErrorIs(t, err, ErrInvalidAddress)
+		require.True(t, IsContractAddressError(err))
+	})
+	t.Run("wallet address", func(t *testing.T) {
+		err := CheckContract(context.Background(), createProxy(nil, nil), testWalletAddress)
+		require.Equal(t, ErrNotSmartContract, err)
+		require.True(t, IsContractAddressError(err))
+	})
+	t.Run("proxy error", func(t *testing.T) {
+		expectedErr := errors.New("proxy error")
+		err := CheckContract(context.Background(), createProxy(nil, expectedErr), testContractAddress)
+		require.ErrorIs(t, err, expectedErr)
+		require.False(t, IsContractAddressError(err))
+	})
+	t.Run("no contract deployed", func(t *testing.T) {
+		err := CheckContract(context.Background(), createProxy(&data.Account{}, nil), testContractAddress)
+		require.Equal(t, ErrNoContractDeployed, err)
+		require.True(t, IsContractAddressError(err))
+	})
+	t.Run("should work", func(t *testing.T) {
+		require.Nil(t, CheckContract(context.Background(), createProxy(&data.Account{CodeHash: []byte("hash")}, nil), testContractAddress))
+		require.Nil(t, CheckContract(context.Background(), createProxy(&data.Account{Code: "code"}, nil), testContractAddress))
+	})
+}
@@ -0,0 +1,21 @@
+package chain
+
+import "errors"
+
+// ErrNilProxy signals that a nil proxy was provided
+var ErrNilProxy = errors.New("nil proxy provided")
+
+// ErrInvalidAddress signals that the address is not a valid bech32 address
+var ErrInvalidAddress = errors.New("invalid bech32 address")
+
+// ErrNotSmartContract signals that the address is not a smart contract address
+var ErrNotSmartContract = errors.New("not a smart contract address")
+
+// ErrNoContractDeployed signals that there is no contract code at the address
+var ErrNoContractDeployed = errors.New("no contract deployed at address")
+
+// ErrInvalidBalance signals that the balance is not a denominated, non negative value
+var ErrInvalidBalance = errors.New("invalid balance")
+
+// ErrInsufficientBalance signals that the wallet balance is zero or below the min balance
+var ErrInsufficientBalance = errors.New("insufficient wallet balance")
@@ -0,0 +1,14 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// AccountProvider defines the proxy method used to fetch accounts
+type AccountProvider interface {
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
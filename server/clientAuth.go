@@ -0,0 +1,59 @@
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// isClientAuthenticated checks that the request was sent over a tls connection on which the client presented a
+// certificate verified against the server client CAs
+func isClientAuthenticated(req *http.Request) bool {
+	return req.TLS != nil && len(req.TLS.VerifiedChains) > 0
+}
+
+// hasBearerToken checks that the request has the provided token in the authorization header. An empty token is never
+// matched.
+func hasBearerToken(req *http.Request, token []byte) bool {
+	if len(token) == 0 {
+		return false
+	}
+
+	authorization := req.Header.Get(authorizationHeader)
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(authorization, bearerPrefix)), token) == 1
+}
+
+// writeGRPCUnauthenticated writes a trailers only grpc response with the unauthenticated status code
+func writeGRPCUnauthenticated(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(codes.Unauthenticated)))
+	w.Header().Set("Grpc-Message", "client certificate required")
+	w.WriteHeader(http.StatusOK)
+}
+
+// requireClientCertificate rejects the requests of clients which did not authenticate with a certificate, the same
+// way grpc clients are required to
+func requireClientCertificate(c *gin.Context) {
+	if !isClientAuthenticated(c.Request) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, &ErrorResponseJSON{
+			Code:    codes.Unauthenticated.String(),
+			Message: "client certificate required",
+		})
+		return
+	}
+
+	c.Next()
+}
@@ -5,10 +5,61 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
 )
 
-// ServerConfig holds necessary config for the grpc server
+// ServerConfig holds necessary config for the grpc server. ShutdownTimeoutInSec is the max time to wait on shutdown
+// for in-flight bridge operations to be sent and SendTimeoutInSec is the max time to send bridge operations received
+// with an idempotency key, zero values using the default timeouts.
 type ServerConfig struct {
-	GRPCPort          string
-	TxSenderConfig    txSender.TxSenderConfig
-	WalletConfig      txSender.WalletConfig
-	CertificateConfig cert.FileCfg
+	LogLevel             string
+	GRPCPort             string
+	ShutdownTimeoutInSec int
+	SendTimeoutInSec     int
+	TxSenderConfig       txSender.TxSenderConfig
+	WalletConfig         txSender.WalletConfig
+	CertificateConfig    cert.FileCfg
+	ValidatorConfig      ValidatorConfig
+	HealthConfig         HealthConfig
+	LogWebSocket         LogWebSocketConfig
+	AdminConfig          AdminConfig
+	PreflightConfig      PreflightConfig
+}
+
+// ValidatorConfig holds the limits of received bridge operations. Zero values use the default limits.
+type ValidatorConfig struct {
+	MaxBridgeData         int
+	MaxOperations         int
+	MaxPayloadSizeInBytes int
+}
+
+// HealthConfig holds the readiness checks config. MinWalletBalance is the minimum wallet balance, in denominated
+// units, for the server to be reported as serving.
+type HealthConfig struct {
+	CheckIntervalInSec int
+	CheckTimeoutInSec  int
+	MinWalletBalance   string
+	EnableReflection   bool
+}
+
+// LogWebSocketConfig holds the logs websocket access config. Subscribers authenticate with a client certificate or,
+// if AuthToken is set, with it as bearer token. Browser origins are accepted only if listed in AllowedOrigins, or only
+// the server host if none are listed. Zero MaxSubscribers uses the default limit.
+type LogWebSocketConfig struct {
+	AuthToken      string
+	AllowedOrigins []string
+	MaxSubscribers int
+}
+
+// AdminConfig holds the admin api config. The admin api is enabled only if AuthToken is set, requests should send it as
+// bearer token. The pause state is persisted in StateFile and the sending config changes are recorded in AuditLogFile,
+// empty values using the default files.
+type AdminConfig struct {
+	AuthToken    string
+	StateFile    string
+	AuditLogFile string
+}
+
+// PreflightConfig holds the checks run before the server starts. ExpectedChainID is the chain id the proxy should be
+// connected to. Zero CheckTimeoutInSec uses the default timeout of each check.
+type PreflightConfig struct {
+	ExpectedChainID   string
+	CheckTimeoutInSec int
 }
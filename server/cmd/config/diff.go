@@ -0,0 +1,49 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ChangedFields returns the paths, separated by dots, of the fields which differ between the two configs. Lists are
+// compared as a whole.
+func ChangedFields(previous *ServerConfig, current *ServerConfig) []string {
+	changed := make([]string, 0)
+	appendChangedFields(&changed, "", reflect.ValueOf(*previous), reflect.ValueOf(*current))
+
+	return changed
+}
+
+func appendChangedFields(changed *[]string, prefix string, previous reflect.Value, current reflect.Value) {
+	if previous.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(previous.Interface(), current.Interface()) {
+			*changed = append(*changed, prefix)
+		}
+		return
+	}
+
+	for i := 0; i < previous.NumField(); i++ {
+		path := previous.Type().Field(i).Name
+		if len(prefix) != 0 {
+			path = prefix + "." + path
+		}
+
+		appendChangedFields(changed, path, previous.Field(i), current.Field(i))
+	}
+}
+
+// MaskedFields returns the changed fields which are overridden, directly or through a parent field, so that their
+// config file values are not used
+func MaskedFields(changedFields []string, overriddenFields []string) []string {
+	masked := make([]string, 0)
+	for _, field := range changedFields {
+		for _, overridden := range overriddenFields {
+			if field == overridden || strings.HasPrefix(field, overridden+".") {
+				masked = append(masked, field)
+				break
+			}
+		}
+	}
+
+	return masked
+}
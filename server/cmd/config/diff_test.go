@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangedFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same config", func(t *testing.T) {
+		require.Empty(t, ChangedFields(createValidConfig(), createValidConfig()))
+	})
+	t.Run("should return the nested fields paths", func(t *testing.T) {
+		current := createValidConfig()
+		current.LogLevel = "*:INFO"
+		current.TxSenderConfig.RegisterGas.GasLimit = 60_000_000
+		current.CertificateConfig.CertFile = "new.crt"
+		current.LogWebSocket.AllowedOrigins = []string{"https://a.com"}
+
+		require.Equal(t, []string{
+			"LogLevel",
+			"TxSenderConfig.RegisterGas.GasLimit",
+			"CertificateConfig.CertFile",
+			"LogWebSocket.AllowedOrigins",
+		}, ChangedFields(createValidConfig(), current))
+	})
+}
+
+func TestMaskedFields(t *testing.T) {
+	t.Parallel()
+
+	changedFields := []string{"LogLevel", "TxSenderConfig.RegisterGas.GasLimit", "ValidatorConfig.MaxBridgeData"}
+
+	require.Empty(t, MaskedFields(changedFields, nil))
+	require.Empty(t, MaskedFields(nil, []string{"LogLevel"}))
+	require.Equal(t, []string{"LogLevel", "TxSenderConfig.RegisterGas.GasLimit"},
+		MaskedFields(changedFields, []string{"TxSenderConfig.RegisterGas", "LogLevel", "GRPCPort"}))
+	require.Empty(t, MaskedFields([]string{"TxSenderConfig.RegisterGasLimit"}, []string{"TxSenderConfig.RegisterGas"}))
+}
@@ -0,0 +1,11 @@
+package config
+
+import "errors"
+
+var errInvalidOverride = errors.New("invalid config override")
+
+var errUnknownField = errors.New("unknown config field")
+
+var errUnsupportedFieldType = errors.New("unsupported config field type")
+
+var errInvalidConfig = errors.New("invalid config")
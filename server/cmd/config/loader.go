@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pelletier/go-toml"
+)
+
+const redactedValue = "<redacted>"
+
+// LoadConfig loads the server config from the toml file. Keys which do not match a config field are reported as
+// errors. An empty path returns an empty config, to be filled with overrides.
+func LoadConfig(path string) (*ServerConfig, error) {
+	cfg := &ServerConfig{}
+	if len(path) == 0 {
+		return cfg, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open config file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	err = toml.NewDecoder(file).Strict(true).Decode(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Redacted returns a copy of the config with all secrets replaced, which is safe to print or log
+func (cfg ServerConfig) Redacted() ServerConfig {
+	redact := func(secret *string) {
+		if len(*secret) != 0 {
+			*secret = redactedValue
+		}
+	}
+
+	redact(&cfg.WalletConfig.Password)
+	redact(&cfg.LogWebSocket.AuthToken)
+	redact(&cfg.AdminConfig.AuthToken)
+
+	return cfg
+}
+
+// MarshalRedacted formats the config as toml, with all secrets redacted
+func (cfg ServerConfig) MarshalRedacted() ([]byte, error) {
+	return toml.Marshal(cfg.Redacted())
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleConfigPath = "../server/config.toml"
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	err := os.WriteFile(path, []byte(content), 0600)
+	require.Nil(t, err)
+
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no file should return empty config", func(t *testing.T) {
+		cfg, err := LoadConfig("")
+		require.Nil(t, err)
+		require.Equal(t, &ServerConfig{}, cfg)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		cfg, err := LoadConfig("missing.toml")
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Nil(t, cfg)
+	})
+	t.Run("unknown key should error", func(t *testing.T) {
+		path := writeConfigFile(t, "GRPCPort = \"8085\"\n[TxSenderConfig]\nProxyURL = \"http://127.0.0.1\"\n")
+
+		cfg, err := LoadConfig(path)
+		require.ErrorContains(t, err, "ProxyURL")
+		require.Nil(t, cfg)
+	})
+	t.Run("invalid value type should error", func(t *testing.T) {
+		path := writeConfigFile(t, "[TxSenderConfig]\nNumWorkers = \"four\"\n")
+
+		cfg, err := LoadConfig(path)
+		require.NotNil(t, err)
+		require.Nil(t, cfg)
+	})
+	t.Run("example config should be valid", func(t *testing.T) {
+		cfg, err := LoadConfig(exampleConfigPath)
+		require.Nil(t, err)
+		require.Nil(t, Validate(cfg))
+
+		require.Equal(t, "8085", cfg.GRPCPort)
+		require.Equal(t, 30, cfg.ShutdownTimeoutInSec)
+		require.Equal(t, 4, cfg.TxSenderConfig.NumWorkers)
+		require.Equal(t, uint64(50000000), cfg.TxSenderConfig.RegisterGas.GasLimit)
+		require.Equal(t, 1.0, cfg.TxSenderConfig.ExecuteGas.GasPriceMultiplier)
+		require.Equal(t, 2097152, cfg.ValidatorConfig.MaxPayloadSizeInBytes)
+		require.Equal(t, "admin_audit.log", cfg.AdminConfig.AuditLogFile)
+		require.Equal(t, "T", cfg.PreflightConfig.ExpectedChainID)
+	})
+}
+
+func TestServerConfig_Redacted(t *testing.T) {
+	t.Parallel()
+
+	cfg := createValidConfig()
+	cfg.WalletConfig.Password = "walletPassword"
+	cfg.LogWebSocket.AuthToken = "logToken"
+	cfg.LogWebSocket.AllowedOrigins = []string{"https://a.com"}
+	cfg.AdminConfig.AuthToken = "adminToken"
+
+	redacted := cfg.Redacted()
+	require.Equal(t, redactedValue, redacted.WalletConfig.Password)
+	require.Equal(t, redactedValue, redacted.LogWebSocket.AuthToken)
+	require.Equal(t, redactedValue, redacted.AdminConfig.AuthToken)
+	require.Equal(t, "walletPassword", cfg.WalletConfig.Password)
+
+	buff, err := cfg.MarshalRedacted()
+	require.Nil(t, err)
+	require.NotContains(t, string(buff), "walletPassword")
+	require.NotContains(t, string(buff), "logToken")
+	require.NotContains(t, string(buff), "adminToken")
+
+	unmarshalled := ServerConfig{}
+	err = toml.Unmarshal(buff, &unmarshalled)
+	require.Nil(t, err)
+	require.Equal(t, redacted, unmarshalled)
+
+	cfg.AdminConfig.AuthToken = ""
+	require.Empty(t, cfg.Redacted().AdminConfig.AuthToken)
+}
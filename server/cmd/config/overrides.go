@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Override binds an environment variable, or a flag, to the config field it overrides. The field is the path of the
+// field in ServerConfig, separated by dots.
+type Override struct {
+	Name  string
+	Field string
+}
+
+// EnvOverrides lists the environment variables which override the config file
+var EnvOverrides = []Override{
+	{Name: "LOG_LEVEL", Field: "LogLevel"},
+	{Name: "GRPC_PORT", Field: "GRPCPort"},
+	{Name: "WALLET_PATH", Field: "WalletConfig.Path"},
+	{Name: "WALLET_PASSWORD", Field: "WalletConfig.Password"},
+	{Name: "DHARITRI_PROXY", Field: "TxSenderConfig.Proxy"},
+	{Name: "HEADER_VERIFIER_SC_ADDRESS", Field: "TxSenderConfig.HeaderVerifierSCAddress"},
+	{Name: "DCDT_SAFE_SC_ADDRESS", Field: "TxSenderConfig.DcdtSafeSCAddress"},
+	{Name: "REGISTER_GAS_LIMIT", Field: "TxSenderConfig.RegisterGas.GasLimit"},
+	{Name: "REGISTER_GAS_PRICE_MULTIPLIER", Field: "TxSenderConfig.RegisterGas.GasPriceMultiplier"},
+	{Name: "EXECUTE_GAS_LIMIT", Field: "TxSenderConfig.ExecuteGas.GasLimit"},
+	{Name: "EXECUTE_GAS_PRICE_MULTIPLIER", Field: "TxSenderConfig.ExecuteGas.GasPriceMultiplier"},
+	{Name: "INTERVAL_TO_SEND", Field: "TxSenderConfig.IntervalToSend"},
+	{Name: "NUM_WORKERS", Field: "TxSenderConfig.NumWorkers"},
+	{Name: "HASHER", Field: "TxSenderConfig.Hasher"},
+	{Name: "CERT_FILE", Field: "CertificateConfig.CertFile"},
+	{Name: "CERT_PK_FILE", Field: "CertificateConfig.PkFile"},
+	{Name: "MAX_BRIDGE_DATA", Field: "ValidatorConfig.MaxBridgeData"},
+	{Name: "MAX_OPERATIONS", Field: "ValidatorConfig.MaxOperations"},
+	{Name: "MAX_PAYLOAD_SIZE_IN_BYTES", Field: "ValidatorConfig.MaxPayloadSizeInBytes"},
+	{Name: "HEALTH_CHECK_INTERVAL_IN_SEC", Field: "HealthConfig.CheckIntervalInSec"},
+	{Name: "HEALTH_CHECK_TIMEOUT_IN_SEC", Field: "HealthConfig.CheckTimeoutInSec"},
+	{Name: "MIN_WALLET_BALANCE", Field: "HealthConfig.MinWalletBalance"},
+	{Name: "ENABLE_REFLECTION", Field: "HealthConfig.EnableReflection"},
+	{Name: "LOG_WS_AUTH_TOKEN", Field: "LogWebSocket.AuthToken"},
+	{Name: "LOG_WS_ALLOWED_ORIGINS", Field: "LogWebSocket.AllowedOrigins"},
+	{Name: "LOG_WS_MAX_SUBSCRIBERS", Field: "LogWebSocket.MaxSubscribers"},
+	{Name: "ADMIN_AUTH_TOKEN", Field: "AdminConfig.AuthToken"},
+	{Name: "ADMIN_STATE_FILE", Field: "AdminConfig.StateFile"},
+	{Name: "ADMIN_AUDIT_LOG_FILE", Field: "AdminConfig.AuditLogFile"},
+	{Name: "EXPECTED_CHAIN_ID", Field: "PreflightConfig.ExpectedChainID"},
+	{Name: "PREFLIGHT_CHECK_TIMEOUT_IN_SEC", Field: "PreflightConfig.CheckTimeoutInSec"},
+	{Name: "SHUTDOWN_TIMEOUT_IN_SEC", Field: "ShutdownTimeoutInSec"},
+	{Name: "SEND_TIMEOUT_IN_SEC", Field: "SendTimeoutInSec"},
+}
+
+// FlagOverrides lists the command line flags which override both the config file and the environment variables
+var FlagOverrides = []Override{
+	{Name: "grpc-port", Field: "GRPCPort"},
+	{Name: "wallet-path", Field: "WalletConfig.Path"},
+	{Name: "proxy", Field: "TxSenderConfig.Proxy"},
+	{Name: "header-verifier-sc-address", Field: "TxSenderConfig.HeaderVerifierSCAddress"},
+	{Name: "dcdt-safe-sc-address", Field: "TxSenderConfig.DcdtSafeSCAddress"},
+	{Name: "hasher", Field: "TxSenderConfig.Hasher"},
+	{Name: "num-workers", Field: "TxSenderConfig.NumWorkers"},
+	{Name: "cert-file", Field: "CertificateConfig.CertFile"},
+	{Name: "cert-pk-file", Field: "CertificateConfig.PkFile"},
+	{Name: "admin-state-file", Field: "AdminConfig.StateFile"},
+	{Name: "expected-chain-id", Field: "PreflightConfig.ExpectedChainID"},
+}
+
+// ApplyOverrides sets the config fields of all overrides found by lookup. Empty values are ignored, so that unset
+// variables do not override the config file. All invalid values are reported, the valid ones being applied anyway.
+func ApplyOverrides(cfg *ServerConfig, overrides []Override, lookup func(name string) (string, bool)) error {
+	problems := make([]string, 0)
+	for _, override := range overrides {
+		value, found := lookup(override.Name)
+		if !found || len(value) == 0 {
+			continue
+		}
+
+		err := setField(cfg, override.Field, value)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s (%s): %s", override.Field, override.Name, err))
+		}
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("%w:\n\t%s", errInvalidOverride, strings.Join(problems, "\n\t"))
+	}
+
+	return nil
+}
+
+// OverriddenFields returns the config fields of the overrides found by lookup. Empty values are ignored, as they do not
+// override the config file.
+func OverriddenFields(overrides []Override, lookup func(name string) (string, bool)) []string {
+	fields := make([]string, 0)
+	for _, override := range overrides {
+		value, found := lookup(override.Name)
+		if found && len(value) != 0 {
+			fields = append(fields, override.Field)
+		}
+	}
+
+	return fields
+}
+
+// setField sets the field found at the dot separated path from its string value. Lists are comma separated.
+func setField(cfg *ServerConfig, path string, value string) error {
+	field := reflect.ValueOf(cfg).Elem()
+	for _, name := range strings.Split(path, ".") {
+		field = field.FieldByName(name)
+		if !field.IsValid() {
+			return fmt.Errorf("%w: %s", errUnknownField, path)
+		}
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(intValue))
+	case reflect.Uint64:
+		uintValue, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(uintValue)
+	case reflect.Float64:
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(floatValue)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(boolValue)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("%w: %s", errUnsupportedFieldType, field.Type())
+		}
+		field.Set(reflect.ValueOf(splitList(value)))
+	default:
+		return fmt.Errorf("%w: %s", errUnsupportedFieldType, field.Type())
+	}
+
+	return nil
+}
+
+func splitList(value string) []string {
+	list := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) != 0 {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
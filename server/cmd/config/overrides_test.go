@@ -0,0 +1,99 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createLookup(values map[string]string) func(name string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, found := values[name]
+		return value, found
+	}
+}
+
+func TestApplyOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should override all field types", func(t *testing.T) {
+		cfg := createValidConfig()
+		err := ApplyOverrides(cfg, EnvOverrides, createLookup(map[string]string{
+			"GRPC_PORT":                      "9000",
+			"NUM_WORKERS":                    "8",
+			"REGISTER_GAS_LIMIT":             "60000000",
+			"EXECUTE_GAS_PRICE_MULTIPLIER":   "1.5",
+			"ENABLE_REFLECTION":              "true",
+			"LOG_WS_ALLOWED_ORIGINS":         "https://a.com, ,https://b.com",
+			"EXPECTED_CHAIN_ID":              "D",
+			"PREFLIGHT_CHECK_TIMEOUT_IN_SEC": "3",
+		}))
+		require.Nil(t, err)
+
+		require.Equal(t, "9000", cfg.GRPCPort)
+		require.Equal(t, 8, cfg.TxSenderConfig.NumWorkers)
+		require.Equal(t, uint64(60000000), cfg.TxSenderConfig.RegisterGas.GasLimit)
+		require.Equal(t, 1.5, cfg.TxSenderConfig.ExecuteGas.GasPriceMultiplier)
+		require.True(t, cfg.HealthConfig.EnableReflection)
+		require.Equal(t, []string{"https://a.com", "https://b.com"}, cfg.LogWebSocket.AllowedOrigins)
+		require.Equal(t, "D", cfg.PreflightConfig.ExpectedChainID)
+		require.Equal(t, 3, cfg.PreflightConfig.CheckTimeoutInSec)
+	})
+	t.Run("empty values should not override", func(t *testing.T) {
+		cfg := createValidConfig()
+		err := ApplyOverrides(cfg, EnvOverrides, createLookup(map[string]string{
+			"GRPC_PORT":   "",
+			"NUM_WORKERS": "",
+		}))
+		require.Nil(t, err)
+		require.Equal(t, createValidConfig(), cfg)
+	})
+	t.Run("flags should override env values", func(t *testing.T) {
+		cfg := createValidConfig()
+		_ = ApplyOverrides(cfg, EnvOverrides, createLookup(map[string]string{"HASHER": "keccak"}))
+		err := ApplyOverrides(cfg, FlagOverrides, createLookup(map[string]string{"hasher": "blake2b"}))
+		require.Nil(t, err)
+		require.Equal(t, "blake2b", cfg.TxSenderConfig.Hasher)
+	})
+	t.Run("invalid values should all be reported, valid ones applied", func(t *testing.T) {
+		cfg := createValidConfig()
+		err := ApplyOverrides(cfg, EnvOverrides, createLookup(map[string]string{
+			"NUM_WORKERS":       "four",
+			"ENABLE_REFLECTION": "maybe",
+			"HASHER":            "keccak",
+		}))
+		require.ErrorIs(t, err, errInvalidOverride)
+		require.Contains(t, err.Error(), "TxSenderConfig.NumWorkers (NUM_WORKERS): ")
+		require.Contains(t, err.Error(), "HealthConfig.EnableReflection (ENABLE_REFLECTION): ")
+		require.Equal(t, "keccak", cfg.TxSenderConfig.Hasher)
+	})
+	t.Run("unknown field", func(t *testing.T) {
+		overrides := []Override{{Name: "PORT", Field: "GRPC.Port"}}
+		err := ApplyOverrides(createValidConfig(), overrides, createLookup(map[string]string{"PORT": "1"}))
+		require.ErrorIs(t, err, errInvalidOverride)
+		require.Contains(t, err.Error(), errUnknownField.Error())
+	})
+	t.Run("all overrides should match config fields", func(t *testing.T) {
+		for _, overrides := range [][]Override{EnvOverrides, FlagOverrides} {
+			for _, override := range overrides {
+				err := setField(&ServerConfig{}, override.Field, "1")
+				require.NotErrorIs(t, err, errUnknownField, override.Name)
+				require.NotErrorIs(t, err, errUnsupportedFieldType, override.Name)
+			}
+		}
+	})
+}
+
+func TestOverriddenFields(t *testing.T) {
+	t.Parallel()
+
+	fields := OverriddenFields(EnvOverrides, createLookup(map[string]string{
+		"LOG_LEVEL":          "*:DEBUG",
+		"GRPC_PORT":          "",
+		"REGISTER_GAS_LIMIT": "60000000",
+		"UNKNOWN":            "value",
+	}))
+	require.Equal(t, []string{"LogLevel", "TxSenderConfig.RegisterGas.GasLimit"}, fields)
+
+	require.Empty(t, OverriddenFields(FlagOverrides, createLookup(nil)))
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) report(field string, format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf("%s: %s", field, fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) required(field string, value string) bool {
+	if len(value) == 0 {
+		v.report(field, "should be set")
+		return false
+	}
+
+	return true
+}
+
+func (v *validator) notNegative(field string, value int) {
+	if value < 0 {
+		v.report(field, "should not be negative, got %d", value)
+	}
+}
+
+func (v *validator) bech32Address(field string, value string) {
+	if !v.required(field, value) {
+		return
+	}
+
+	_, err := data.NewAddressFromBech32String(value)
+	if err != nil {
+		v.report(field, "invalid bech32 address %s: %s", value, err)
+	}
+}
+
+// Validate checks the config values, reporting all problems found along with their field names. Contracts, proxy,
+// wallet and certificate files are checked by the preflight checks.
+func Validate(cfg *ServerConfig) error {
+	v := &validator{}
+
+	if len(cfg.LogLevel) != 0 {
+		_, _, err := logger.ParseLogLevelAndMatchingString(cfg.LogLevel)
+		if err != nil {
+			v.report("LogLevel", "invalid log level patterns %q: %s", cfg.LogLevel, err)
+		}
+	}
+
+	port, err := strconv.Atoi(cfg.GRPCPort)
+	if err != nil || port <= 0 || port > 65535 {
+		v.report("GRPCPort", "should be a port number, got %q", cfg.GRPCPort)
+	}
+
+	v.required("WalletConfig.Path", cfg.WalletConfig.Path)
+	validateTxSenderConfig(v, cfg.TxSenderConfig)
+	v.required("CertificateConfig.CertFile", cfg.CertificateConfig.CertFile)
+	v.required("CertificateConfig.PkFile", cfg.CertificateConfig.PkFile)
+
+	v.notNegative("ValidatorConfig.MaxBridgeData", cfg.ValidatorConfig.MaxBridgeData)
+	v.notNegative("ValidatorConfig.MaxOperations", cfg.ValidatorConfig.MaxOperations)
+	v.notNegative("ValidatorConfig.MaxPayloadSizeInBytes", cfg.ValidatorConfig.MaxPayloadSizeInBytes)
+
+	v.notNegative("HealthConfig.CheckIntervalInSec", cfg.HealthConfig.CheckIntervalInSec)
+	v.notNegative("HealthConfig.CheckTimeoutInSec", cfg.HealthConfig.CheckTimeoutInSec)
+	if len(cfg.HealthConfig.MinWalletBalance) != 0 {
+		_, err := chain.ParseMinBalance(cfg.HealthConfig.MinWalletBalance)
+		if err != nil {
+			v.report("HealthConfig.MinWalletBalance", "should be a denominated balance, got %q", cfg.HealthConfig.MinWalletBalance)
+		}
+	}
+
+	v.notNegative("LogWebSocket.MaxSubscribers", cfg.LogWebSocket.MaxSubscribers)
+
+	v.required("PreflightConfig.ExpectedChainID", cfg.PreflightConfig.ExpectedChainID)
+	v.notNegative("PreflightConfig.CheckTimeoutInSec", cfg.PreflightConfig.CheckTimeoutInSec)
+	v.notNegative("ShutdownTimeoutInSec", cfg.ShutdownTimeoutInSec)
+	v.notNegative("SendTimeoutInSec", cfg.SendTimeoutInSec)
+
+	if len(v.problems) != 0 {
+		return fmt.Errorf("%w:\n\t%s", errInvalidConfig, strings.Join(v.problems, "\n\t"))
+	}
+
+	return nil
+}
+
+func validateTxSenderConfig(v *validator, cfg txSender.TxSenderConfig) {
+	if v.required("TxSenderConfig.Proxy", cfg.Proxy) {
+		proxyURL, err := url.Parse(cfg.Proxy)
+		if err != nil || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || len(proxyURL.Host) == 0 {
+			v.report("TxSenderConfig.Proxy", "should be an http or https url, got %q", cfg.Proxy)
+		}
+	}
+
+	v.bech32Address("TxSenderConfig.HeaderVerifierSCAddress", cfg.HeaderVerifierSCAddress)
+	v.bech32Address("TxSenderConfig.DcdtSafeSCAddress", cfg.DcdtSafeSCAddress)
+
+	err := txSender.CheckGasConfig(cfg.RegisterGas)
+	if err != nil {
+		v.report("TxSenderConfig.RegisterGas", "%s", err)
+	}
+	err = txSender.CheckGasConfig(cfg.ExecuteGas)
+	if err != nil {
+		v.report("TxSenderConfig.ExecuteGas", "%s", err)
+	}
+
+	if cfg.IntervalToSend <= 0 {
+		v.report("TxSenderConfig.IntervalToSend", "should be a positive number of milliseconds, got %d", cfg.IntervalToSend)
+	}
+	if cfg.NumWorkers <= 0 {
+		v.report("TxSenderConfig.NumWorkers", "should be positive, got %d", cfg.NumWorkers)
+	}
+	if v.required("TxSenderConfig.Hasher", cfg.Hasher) {
+		_, err = factory.NewHasher(cfg.Hasher)
+		if err != nil {
+			v.report("TxSenderConfig.Hasher", "%s: %s", err, cfg.Hasher)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+func createValidConfig() *ServerConfig {
+	return &ServerConfig{
+		GRPCPort: "8085",
+		TxSenderConfig: txSender.TxSenderConfig{
+			Proxy:                   "http://127.0.0.1:8086",
+			HeaderVerifierSCAddress: "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7",
+			DcdtSafeSCAddress:       "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpq2j2ext",
+			IntervalToSend:          1,
+			NumWorkers:              4,
+			Hasher:                  "sha256",
+		},
+		WalletConfig: txSender.WalletConfig{
+			Path: "wallet.pem",
+		},
+		CertificateConfig: cert.FileCfg{
+			CertFile: "certificate.crt",
+			PkFile:   "private_key.pem",
+		},
+		HealthConfig: HealthConfig{
+			MinWalletBalance: "10",
+		},
+		PreflightConfig: PreflightConfig{
+			ExpectedChainID: "T",
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid config", func(t *testing.T) {
+		require.Nil(t, Validate(createValidConfig()))
+	})
+	t.Run("empty config should report all required fields", func(t *testing.T) {
+		err := Validate(&ServerConfig{})
+		require.ErrorIs(t, err, errInvalidConfig)
+
+		for _, field := range []string{
+			"GRPCPort",
+			"WalletConfig.Path",
+			"TxSenderConfig.Proxy",
+			"TxSenderConfig.HeaderVerifierSCAddress",
+			"TxSenderConfig.DcdtSafeSCAddress",
+			"TxSenderConfig.IntervalToSend",
+			"TxSenderConfig.NumWorkers",
+			"TxSenderConfig.Hasher",
+			"CertificateConfig.CertFile",
+			"CertificateConfig.PkFile",
+			"PreflightConfig.ExpectedChainID",
+		} {
+			require.Contains(t, err.Error(), "\n\t"+field+": ")
+		}
+	})
+	t.Run("invalid values should be reported with their fields", func(t *testing.T) {
+		cfg := createValidConfig()
+		cfg.LogLevel = "*:LOUD"
+		cfg.GRPCPort = "70000"
+		cfg.ShutdownTimeoutInSec = -1
+		cfg.TxSenderConfig.Proxy = "127.0.0.1:8086"
+		cfg.TxSenderConfig.HeaderVerifierSCAddress = "headerVerifier"
+		cfg.TxSenderConfig.RegisterGas.GasPriceMultiplier = 20
+		cfg.TxSenderConfig.Hasher = "md5"
+		cfg.ValidatorConfig.MaxOperations = -1
+		cfg.HealthConfig.MinWalletBalance = "-5"
+		cfg.LogWebSocket.MaxSubscribers = -2
+
+		err := Validate(cfg)
+		require.ErrorIs(t, err, errInvalidConfig)
+		require.Contains(t, err.Error(), "LogLevel: invalid log level patterns \"*:LOUD\"")
+		require.Contains(t, err.Error(), "GRPCPort: should be a port number, got \"70000\"")
+		require.Contains(t, err.Error(), "ShutdownTimeoutInSec: should not be negative, got -1")
+		require.Contains(t, err.Error(), "TxSenderConfig.Proxy: should be an http or https url")
+		require.Contains(t, err.Error(), "TxSenderConfig.HeaderVerifierSCAddress: invalid bech32 address headerVerifier")
+		require.Contains(t, err.Error(), "TxSenderConfig.RegisterGas: ")
+		require.Contains(t, err.Error(), "TxSenderConfig.Hasher: ")
+		require.Contains(t, err.Error(), "ValidatorConfig.MaxOperations: should not be negative, got -1")
+		require.Contains(t, err.Error(), "HealthConfig.MinWalletBalance: ")
+		require.Contains(t, err.Error(), "LogWebSocket.MaxSubscribers: should not be negative, got -2")
+		require.NotContains(t, err.Error(), "TxSenderConfig.DcdtSafeSCAddress")
+		require.NotContains(t, err.Error(), "TxSenderConfig.ExecuteGas")
+	})
+}
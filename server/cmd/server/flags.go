@@ -1,8 +1,12 @@
 package main
 
 import (
+	"fmt"
+
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
 )
 
 var (
@@ -11,15 +15,33 @@ var (
 		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
 			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,fork:DEBUG" +
 			" the logs for all packages will have the INFO level, excepting the fork package which will receive a DEBUG" +
-			" log level.",
+			" log level. If set, it overrides the LogLevel config field, otherwise it is used only if that is not set.",
 		Value: "*:" + logger.LogDebug.String(),
 	}
 	logSaveFile = cli.BoolFlag{
 		Name:  "log-save",
 		Usage: "Boolean option for enabling log saving. If set, it will automatically save all the logs into a file.",
 	}
+	configFilePath = cli.StringFlag{
+		Name: "config",
+		Usage: "The `filepath` of the toml config file. Its values are overridden by the environment variables, which" +
+			" can also be set in the .env file, and by the command line flags.",
+	}
 	disableAnsiColor = cli.BoolFlag{
 		Name:  "disable-ansi-color",
 		Usage: "Boolean option for disabling ANSI colors in the logging system.",
 	}
 )
+
+// createOverrideFlags creates the flags overriding the config fields
+func createOverrideFlags() []cli.Flag {
+	flags := make([]cli.Flag, 0, len(config.FlagOverrides))
+	for _, override := range config.FlagOverrides {
+		flags = append(flags, cli.StringFlag{
+			Name:  override.Name,
+			Usage: fmt.Sprintf("Overrides the %s config field.", override.Field),
+		})
+	}
+
+	return flags
+}
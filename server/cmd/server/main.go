@@ -1,22 +1,23 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
 	"syscall"
 	"time"
 
-	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
-	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/preflight"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/core/closing"
-	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"github.com/TerraDharitri/drt-go-chain-logger/file"
@@ -28,33 +29,44 @@ import (
 
 var log = logger.GetOrCreate("sov-bridge-sender")
 
-const (
-	retrialTimeServe = 1
-	logsPath         = "logs"
-	logsPrefix       = "sov-bridge-sender"
-	logLifeSpanMb    = 1024   //# 1GB
-	logLifeSpanSec   = 432000 // 5 days
-)
+// appVersion should be populated at build time using ldflags
+// Usage examples:
+// linux/mac:
+//
+//	go build -ldflags="-X main.appVersion=$(git describe --tags --long --dirty)"
+var appVersion = "undefined"
 
 const (
-	envGRPCPort             = "GRPC_PORT"
-	envWallet               = "WALLET_PATH"
-	envPassword             = "WALLET_PASSWORD"
-	envHeaderVerifierSCAddr = "HEADER_VERIFIER_SC_ADDRESS"
-	envDcdtSafeSCAddr       = "DCDT_SAFE_SC_ADDRESS"
-	envDharitriProxy        = "DHARITRI_PROXY"
-	envIntervalToSend       = "INTERVAL_TO_SEND"
-	envCertFile             = "CERT_FILE"
-	envCertPkFile           = "CERT_PK_FILE"
-	envHasher               = "HASHER"
+	defaultShutdownTimeoutInSec = 30
+	logsPath                    = "logs"
+	logsPrefix                  = "sov-bridge-sender"
+	logLifeSpanMb               = 1024   //# 1GB
+	logLifeSpanSec              = 432000 // 5 days
+	envFile                     = ".env"
 )
 
 func main() {
 	app := cli.NewApp()
 	app.Name = "Sovereign bridge tx server"
+	app.Version = appVersion
 	app.Action = startServer
-	app.Flags = []cli.Flag{
+	app.Flags = append([]cli.Flag{
 		logLevel,
+		logSaveFile,
+		disableAnsiColor,
+		configFilePath,
+	}, createOverrideFlags()...)
+	app.Commands = []cli.Command{
+		{
+			Name:   "check",
+			Usage:  "Runs the preflight checks of the server config, proxy, contracts and wallet, without starting the server",
+			Action: checkServer,
+		},
+		{
+			Name:   "print-config",
+			Usage:  "Prints the effective config, after applying the environment variables and flags, with secrets redacted",
+			Action: printConfig,
+		},
 	}
 
 	err := app.Run(os.Args)
@@ -65,34 +77,91 @@ func main() {
 }
 
 func startServer(ctx *cli.Context) error {
-	cfg, err := loadConfig()
+	loaded, err := loadConfigSources(ctx)
+	if err != nil {
+		return err
+	}
+	cfg := loaded.effective
+
+	logFile, err := initializeLogger(ctx, cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+
+	err = runPreflightChecks(cfg)
+	if err != nil {
+		return err
+	}
+
+	tlsReloader, err := server.NewTLSReloader(cfg.CertificateConfig)
 	if err != nil {
 		return err
 	}
 
-	logFile, err := initializeLogger(ctx)
+	components, err := server.CreateComponents(cfg)
 	if err != nil {
 		return err
 	}
 
-	tlsConfig, err := cert.LoadTLSServerConfig(cfg.CertificateConfig)
+	certificateExpiry, err := tlsReloader.CertificateExpiry()
 	if err != nil {
 		return err
 	}
+	components.Metrics.SetCertificateExpiry(certificateExpiry)
 
-	tlsCredentials := credentials.NewTLS(tlsConfig)
+	logStreamer, err := server.NewLogStreamer(&marshal.GogoProtoMarshalizer{}, cfg.LogWebSocket)
+	if err != nil {
+		return err
+	}
+
+	configReloader, err := server.NewConfigReloader(server.ArgsConfigReloader{
+		Config:        cfg,
+		TLSReloader:   tlsReloader,
+		Validator:     components.Validator,
+		LogStreamer:   logStreamer,
+		ConfigUpdater: components.ConfigUpdater,
+		Metrics:       components.Metrics,
+	})
+	if err != nil {
+		return err
+	}
+
+	interceptors, err := server.NewUnaryInterceptors(components.Metrics, components.Metrics)
+	if err != nil {
+		return err
+	}
+
+	tlsCredentials := credentials.NewTLS(tlsReloader.TLSConfig(tls.RequireAndVerifyClientCert))
 	grpcServer := grpc.NewServer(
 		grpc.Creds(tlsCredentials),
+		grpc.ChainUnaryInterceptor(interceptors...),
 	)
-	bridgeServer, err := server.CreateSovereignBridgeServer(cfg)
+
+	server.RegisterServices(grpcServer, components, cfg.HealthConfig)
+	components.HealthMonitor.StartChecks()
+	log.Info("starting server...")
+
+	statusProvider, err := server.NewStatusProvider(server.ArgsStatusProvider{
+		Version:       appVersion,
+		WalletAddress: components.WalletAddress,
+		HealthMonitor: components.HealthMonitor,
+		TxStats:       components.TxSender,
+		SendingConfig: components.ConfigUpdater,
+	})
 	if err != nil {
 		return err
 	}
 
-	sovereign.RegisterBridgeTxSenderServer(grpcServer, bridgeServer)
-	log.Info("starting server...")
-
-	ginHandler, err := server.NewGinHandler(&marshal.GogoProtoMarshalizer{})
+	ginHandler, err := server.NewGinHandler(server.ArgsGinHandler{
+		LogStreamer:    logStreamer,
+		MetricsHandler: components.Metrics.Handler(),
+		StatusProvider: statusProvider,
+		BridgeServer:   components.BridgeServer,
+		Interceptors:   interceptors,
+		Admin:          components.Admin,
+		ConfigUpdater:  components.ConfigUpdater,
+		AdminAuthToken: cfg.AdminConfig.AuthToken,
+	})
 	if err != nil {
 		return err
 	}
@@ -102,92 +171,249 @@ func startServer(ctx *cli.Context) error {
 		return err
 	}
 
+	// client certificates are verified during the handshake if presented, but only required by the grpc and bridge
+	// operations handlers, so that health checks and metrics can be scraped without one. The certificate is reloaded on
+	// SIGHUP, along with the other reloadable config fields
+	httpServer := &http.Server{
+		Addr:      fmt.Sprintf(":%s", cfg.GRPCPort),
+		Handler:   serverHandler,
+		TLSConfig: tlsReloader.TLSConfig(tls.VerifyClientCertIfGiven),
+	}
+
+	listener, err := net.Listen("tcp", httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	serveErr := make(chan error, 1)
 	go func() {
-		for {
-			err = http.ListenAndServeTLS(
-				fmt.Sprintf(":%s", cfg.GRPCPort),
-				cfg.CertificateConfig.CertFile,
-				cfg.CertificateConfig.PkFile,
-				serverHandler,
-			)
-			if err != nil {
-				log.Error("sovereign bridge tx sender: ListenAndServeTLS", "error", err)
-				time.Sleep(retrialTimeServe * time.Second)
-			}
+		errServe := httpServer.ServeTLS(listener, "", "")
+		if !errors.Is(errServe, http.ErrServerClosed) {
+			serveErr <- errServe
 		}
 	}()
 
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
 
-	<-interrupt
-	log.Info("closing app at user's signal")
+	err = waitForInterrupt(ctx, interrupt, reload, serveErr, configReloader, loaded.file)
+	if err != nil {
+		log.Error("sovereign bridge tx sender: could not serve", "error", err)
+	} else {
+		log.Info("closing app at user's signal")
+	}
 
-	grpcServer.Stop()
+	shutdown(httpServer, grpcServer, components, getShutdownTimeout(cfg))
 
 	if !check.IfNilReflect(logFile) {
-		err = logFile.Close()
-		log.LogIfError(err)
+		log.LogIfError(logFile.Close())
+	}
+
+	return err
+}
+
+// waitForInterrupt reloads the config on each reload signal, until an interrupt signal is received or the server stops
+// serving, in which case the serve error is returned. The config file values are kept to find the changes masked by
+// environment variables or flags.
+func waitForInterrupt(
+	ctx *cli.Context,
+	interrupt chan os.Signal,
+	reload chan os.Signal,
+	serveErr chan error,
+	configReloader server.ConfigReloader,
+	fileCfg *config.ServerConfig,
+) error {
+	for {
+		select {
+		case <-interrupt:
+			return nil
+		case err := <-serveErr:
+			return err
+		case <-reload:
+			log.Info("reloading config at user's signal")
+			fileCfg = reloadConfig(ctx, configReloader, fileCfg)
+		}
+	}
+}
+
+// shutdown stops accepting connections and bridge operations, then waits, up to the timeout, for the in-flight bridge
+// operations to be sent. Txs not sent by then are rejected as unavailable, so that clients can send them again later.
+func shutdown(httpServer *http.Server, grpcServer *grpc.Server, components *server.Components, timeout time.Duration) {
+	log.Info("shutting down server", "timeout", timeout)
+
+	// health watchers are notified first, so that clients stop sending new bridge operations
+	log.LogIfError(components.HealthMonitor.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// waits for the running requests, but not for hijacked connections, such as the logs websockets
+	err := httpServer.Shutdown(ctx)
+	if err != nil {
+		log.Warn("could not wait for all requests to finish", "error", err)
+	}
+
+	err = components.TxSender.Shutdown(ctx)
+	if err != nil {
+		log.Warn("could not send all txs before shutting down", "error", err)
+	}
+
+	// closes the connections still open after the timeout
+	log.LogIfError(httpServer.Close())
+	grpcServer.Stop()
+
+	log.Info("server shut down")
+}
+
+func getShutdownTimeout(cfg *config.ServerConfig) time.Duration {
+	if cfg.ShutdownTimeoutInSec <= 0 {
+		return defaultShutdownTimeoutInSec * time.Second
+	}
+
+	return time.Duration(cfg.ShutdownTimeoutInSec) * time.Second
+}
+
+// reloadConfig loads the config again and applies its reloadable fields, returning the config file values of the last
+// applied config. Invalid configs are not applied at all. Changes of the config file fields overridden by environment
+// variables or flags are reported as not applied, since the overrides keep their values from startup.
+func reloadConfig(ctx *cli.Context, configReloader server.ConfigReloader, previousFileCfg *config.ServerConfig) *config.ServerConfig {
+	loaded, err := loadConfigSources(ctx)
+	if err != nil {
+		log.Error("could not load config, the current config is kept", "error", err)
+		return previousFileCfg
+	}
+
+	maskedFields := config.MaskedFields(config.ChangedFields(previousFileCfg, loaded.file), loaded.overriddenFields)
+	_, err = configReloader.Reload(context.Background(), loaded.effective, maskedFields)
+	if err != nil {
+		log.Error("could not reload config", "error", err)
+		return previousFileCfg
+	}
+
+	return loaded.file
+}
+
+func checkServer(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	return runPreflightChecks(cfg)
+}
+
+// runPreflightChecks prints the preflight report, returning an error if any check failed
+func runPreflightChecks(cfg *config.ServerConfig) error {
+	checker, err := preflight.CreatePreflightChecker(cfg)
+	if err != nil {
+		return err
+	}
+
+	report := checker.Run(context.Background())
+	fmt.Print(report.String())
+	if !report.Passed() {
+		return fmt.Errorf("preflight checks failed: %d of %d", report.NumFailed(), len(report.Results))
 	}
 
 	return nil
 }
 
-func loadConfig() (*config.ServerConfig, error) {
-	err := godotenv.Load(".env")
+// loadedConfig holds the effective config, along with the config file values and the fields overridden by the
+// environment variables and the command line flags
+type loadedConfig struct {
+	effective        *config.ServerConfig
+	file             *config.ServerConfig
+	overriddenFields []string
+}
+
+// loadConfig loads the config file set by the config flag, overridden by the environment variables, which can be set
+// in the .env file, and by the command line flags, in this order
+func loadConfig(ctx *cli.Context) (*config.ServerConfig, error) {
+	loaded, err := loadConfigSources(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	grpcPort := os.Getenv(envGRPCPort)
-	walletPath := os.Getenv(envWallet)
-	walletPassword := os.Getenv(envPassword)
-	headerVerifierSCAddress := os.Getenv(envHeaderVerifierSCAddr)
-	dcdtSafeSCAddress := os.Getenv(envDcdtSafeSCAddr)
-	proxy := os.Getenv(envDharitriProxy)
-	intervalToSendStr := os.Getenv(envIntervalToSend)
-	certFile := os.Getenv(envCertFile)
-	certPkFile := os.Getenv(envCertPkFile)
-	hasher := os.Getenv(envHasher)
+	return loaded.effective, nil
+}
+
+func loadConfigSources(ctx *cli.Context) (*loadedConfig, error) {
+	err := godotenv.Load(envFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
 
-	intervalToSend, err := strconv.Atoi(intervalToSendStr)
+	configFile := ctx.GlobalString(configFilePath.Name)
+	cfg, err := config.LoadConfig(configFile)
 	if err != nil {
 		return nil, err
 	}
+	fileCfg := *cfg
 
-	log.Info("loaded config", "grpc port", grpcPort)
-	log.Info("loaded config", "headerVerifierSCAddress", headerVerifierSCAddress)
-	log.Info("loaded config", "dcdtSafeSCAddress", dcdtSafeSCAddress)
-	log.Info("loaded config", "proxy", proxy)
-	log.Info("loaded config", "intervalToSend", intervalToSend)
-	log.Info("loaded config", "hasher", hasher)
+	flagLookup := func(name string) (string, bool) {
+		return ctx.GlobalString(name), ctx.GlobalIsSet(name)
+	}
+	overriddenFields := config.OverriddenFields(config.EnvOverrides, os.LookupEnv)
+	overriddenFields = append(overriddenFields, config.OverriddenFields(config.FlagOverrides, flagLookup)...)
+
+	// invalid overrides are reported along with the validation problems, so that all of them are fixed at once
+	errEnv := config.ApplyOverrides(cfg, config.EnvOverrides, os.LookupEnv)
+	errFlags := config.ApplyOverrides(cfg, config.FlagOverrides, flagLookup)
+
+	// the log level flag has a default value, used only if the log level is not set otherwise
+	if ctx.GlobalIsSet(logLevel.Name) {
+		cfg.LogLevel = ctx.GlobalString(logLevel.Name)
+		overriddenFields = append(overriddenFields, "LogLevel")
+	} else if len(cfg.LogLevel) == 0 {
+		cfg.LogLevel = ctx.GlobalString(logLevel.Name)
+	}
 
-	log.Info("loaded config", "certificate file", certFile)
-	log.Info("loaded config", "certificate pk", certPkFile)
+	err = errors.Join(errEnv, errFlags, config.Validate(cfg))
+	if err != nil {
+		return nil, err
+	}
 
-	return &config.ServerConfig{
-		GRPCPort: grpcPort,
-		WalletConfig: txSender.WalletConfig{
-			Path:     walletPath,
-			Password: walletPassword,
-		},
-		TxSenderConfig: txSender.TxSenderConfig{
-			HeaderVerifierSCAddress: headerVerifierSCAddress,
-			DcdtSafeSCAddress:       dcdtSafeSCAddress,
-			Proxy:                   proxy,
-			IntervalToSend:          intervalToSend,
-			Hasher:                  hasher,
-		},
-		CertificateConfig: cert.FileCfg{
-			CertFile: certFile,
-			PkFile:   certPkFile,
-		},
+	log.Info("loaded config", "file", configFile, "grpc port", cfg.GRPCPort, "proxy", cfg.TxSenderConfig.Proxy)
+	log.Info("loaded config", "headerVerifierSCAddress", cfg.TxSenderConfig.HeaderVerifierSCAddress,
+		"dcdtSafeSCAddress", cfg.TxSenderConfig.DcdtSafeSCAddress)
+	log.Debug("loaded config", "config", fmt.Sprintf("%+v", cfg.Redacted()))
+
+	return &loadedConfig{
+		effective:        cfg,
+		file:             &fileCfg,
+		overriddenFields: overriddenFields,
 	}, nil
 }
 
-func initializeLogger(ctx *cli.Context) (closing.Closer, error) {
-	logLevelFlagValue := ctx.GlobalString(logLevel.Name)
-	err := logger.SetLogLevel(logLevelFlagValue)
+func printConfig(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	buff, err := cfg.MarshalRedacted()
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(string(buff))
+	return nil
+}
+
+func initializeLogger(ctx *cli.Context, logLevelPatterns string) (closing.Closer, error) {
+	err := logger.SetLogLevel(logLevelPatterns)
 	if err != nil {
 		return nil, err
 	}
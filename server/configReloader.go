@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+const reloadActor = "config reload"
+
+// reloadableFields are the config fields, along with their nested fields, applied without restarting the server. The
+// server has no client allow-list nor rate limits of its own: clients are authorized by certificates issued from the
+// server certificate, so the allowed clients change along with the reloaded certificate, and the only request limits
+// are the validator ones.
+var reloadableFields = []string{
+	"LogLevel",
+	"CertificateConfig",
+	"ValidatorConfig",
+	"LogWebSocket",
+	"TxSenderConfig.RegisterGas",
+	"TxSenderConfig.ExecuteGas",
+}
+
+var gasFields = []string{"TxSenderConfig.RegisterGas", "TxSenderConfig.ExecuteGas"}
+
+// ReloadResult holds the config fields changed since the last loaded config, split by whether they were applied or
+// not, the ones not applied needing a restart or being masked by environment variables or flags
+type ReloadResult struct {
+	Applied    []string
+	NotApplied []string
+}
+
+// ArgsConfigReloader holds the arguments needed to create a config reloader
+type ArgsConfigReloader struct {
+	Config        *config.ServerConfig
+	TLSReloader   TLSReloader
+	Validator     ReloadableValidator
+	LogStreamer   LogStreamer
+	ConfigUpdater SendingConfigUpdater
+	Metrics       MetricsHandler
+}
+
+// gasPatch holds the sending config fields changed by a reload, the bridge contracts being changed only through the
+// admin api
+type gasPatch struct {
+	RegisterGas txSender.GasConfig `json:"registerGas"`
+	ExecuteGas  txSender.GasConfig `json:"executeGas"`
+}
+
+type configReloader struct {
+	mut           sync.Mutex
+	cfg           config.ServerConfig
+	tlsReloader   TLSReloader
+	validator     ReloadableValidator
+	logStreamer   LogStreamer
+	configUpdater SendingConfigUpdater
+	metrics       MetricsHandler
+}
+
+// NewConfigReloader creates the component which applies the reloadable parts of a new config to the running server:
+// log levels, certificate files, bridge operations limits, logs websocket access and gas settings
+func NewConfigReloader(args ArgsConfigReloader) (*configReloader, error) {
+	if args.Config == nil {
+		return nil, errNilConfig
+	}
+	if check.IfNil(args.TLSReloader) {
+		return nil, errNilTLSReloader
+	}
+	if check.IfNil(args.Validator) {
+		return nil, errNilValidator
+	}
+	if check.IfNil(args.LogStreamer) {
+		return nil, errNilLogStreamer
+	}
+	if check.IfNil(args.ConfigUpdater) {
+		return nil, errNilConfigUpdater
+	}
+	if check.IfNilReflect(args.Metrics) {
+		return nil, errNilMetricsHandler
+	}
+
+	return &configReloader{
+		cfg:           *args.Config,
+		tlsReloader:   args.TLSReloader,
+		validator:     args.Validator,
+		logStreamer:   args.LogStreamer,
+		configUpdater: args.ConfigUpdater,
+		metrics:       args.Metrics,
+	}, nil
+}
+
+// Reload applies the reloadable fields of the validated config. Certificate files are always reloaded, since they can
+// be renewed in place. Gas settings are applied only if changed since the last loaded config, so that the ones updated
+// through the admin api are kept otherwise. Changes of other fields are reported as not applied, as they need a restart.
+// All changes are loaded and checked before applying any of them, so that a config which can not be applied as a whole
+// is not applied at all. The masked fields are the ones changed in the config file, but overridden by environment
+// variables or flags, so they are reported as not applied as well.
+func (cr *configReloader) Reload(ctx context.Context, cfg *config.ServerConfig, maskedFields []string) (ReloadResult, error) {
+	cr.mut.Lock()
+	defer cr.mut.Unlock()
+
+	changedFields := config.ChangedFields(&cr.cfg, cfg)
+	shouldUpdateGas := containsField(changedFields, gasFields...)
+
+	tlsConfig, err := cert.LoadTLSServerConfig(cfg.CertificateConfig)
+	if err != nil {
+		return ReloadResult{}, fmt.Errorf("could not reload certificate %s: %w", cfg.CertificateConfig.CertFile, err)
+	}
+	certificateExpiry, err := certificateExpiry(tlsConfig)
+	if err != nil {
+		return ReloadResult{}, err
+	}
+
+	_, _, err = logger.ParseLogLevelAndMatchingString(cfg.LogLevel)
+	if err != nil {
+		return ReloadResult{}, fmt.Errorf("invalid log level %s: %w", cfg.LogLevel, err)
+	}
+
+	var patch []byte
+	if shouldUpdateGas {
+		patch, err = createGasPatch(cfg.TxSenderConfig)
+		if err != nil {
+			return ReloadResult{}, err
+		}
+	}
+
+	// the gas settings are applied first, being the only change which can still fail, on writing the audit log
+	if shouldUpdateGas {
+		_, err = cr.configUpdater.UpdateSendingConfig(ctx, patch, reloadActor)
+		if err != nil {
+			return ReloadResult{}, fmt.Errorf("could not update gas settings: %w", err)
+		}
+		cr.cfg.TxSenderConfig.RegisterGas = cfg.TxSenderConfig.RegisterGas
+		cr.cfg.TxSenderConfig.ExecuteGas = cfg.TxSenderConfig.ExecuteGas
+	}
+
+	cr.tlsReloader.SetTLSConfig(tlsConfig)
+	cr.metrics.SetCertificateExpiry(certificateExpiry)
+	cr.cfg.CertificateConfig = cfg.CertificateConfig
+
+	if cfg.LogLevel != cr.cfg.LogLevel {
+		log.LogIfError(logger.SetLogLevel(cfg.LogLevel))
+		cr.cfg.LogLevel = cfg.LogLevel
+	}
+
+	cr.validator.SetConfig(cfg.ValidatorConfig)
+	cr.cfg.ValidatorConfig = cfg.ValidatorConfig
+
+	cr.logStreamer.SetConfig(cfg.LogWebSocket)
+	cr.cfg.LogWebSocket = cfg.LogWebSocket
+
+	result := createReloadResult(changedFields)
+	log.Info("config reloaded", "applied", strings.Join(result.Applied, ", "))
+	if len(result.NotApplied) != 0 {
+		log.Warn("config changes not applied, the server should be restarted for them",
+			"fields", strings.Join(result.NotApplied, ", "))
+	}
+	if len(maskedFields) != 0 {
+		log.Warn("config file changes not applied, the fields are overridden by environment variables or flags",
+			"fields", strings.Join(maskedFields, ", "))
+		result.NotApplied = append(result.NotApplied, maskedFields...)
+	}
+
+	return result, nil
+}
+
+// createGasPatch creates the sending config patch holding the gas settings, checked against the same limits as the
+// ones updated through the admin api
+func createGasPatch(cfg txSender.TxSenderConfig) ([]byte, error) {
+	patch := &gasPatch{
+		RegisterGas: txSender.ApplyGasDefaults(cfg.RegisterGas),
+		ExecuteGas:  txSender.ApplyGasDefaults(cfg.ExecuteGas),
+	}
+
+	err := txSender.CheckGasConfig(patch.RegisterGas)
+	if err != nil {
+		return nil, fmt.Errorf("invalid register gas: %w", err)
+	}
+	err = txSender.CheckGasConfig(patch.ExecuteGas)
+	if err != nil {
+		return nil, fmt.Errorf("invalid execute gas: %w", err)
+	}
+
+	return json.Marshal(patch)
+}
+
+// createReloadResult splits the changed fields, once applied, by whether they are reloadable
+func createReloadResult(changedFields []string) ReloadResult {
+	result := ReloadResult{
+		Applied:    make([]string, 0),
+		NotApplied: make([]string, 0),
+	}
+	for _, field := range changedFields {
+		if containsField([]string{field}, reloadableFields...) {
+			result.Applied = append(result.Applied, field)
+		} else {
+			result.NotApplied = append(result.NotApplied, field)
+		}
+	}
+
+	return result
+}
+
+// containsField checks if any of the fields is one of the parent fields or is nested in it
+func containsField(fields []string, parentFields ...string) bool {
+	for _, field := range fields {
+		for _, parent := range parentFields {
+			if field == parent || strings.HasPrefix(field, parent+".") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (cr *configReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
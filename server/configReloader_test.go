@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/metrics"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/adminMocks"
+)
+
+func createReloadConfig(t *testing.T) *config.ServerConfig {
+	return &config.ServerConfig{
+		LogLevel: "*:INFO",
+		GRPCPort: "8085",
+		TxSenderConfig: txSender.TxSenderConfig{
+			HeaderVerifierSCAddress: "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7",
+			DcdtSafeSCAddress:       "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpq2j2ext",
+			RegisterGas:             txSender.GasConfig{GasLimit: 50_000_000},
+		},
+		CertificateConfig: createCertificateFiles(t, 30),
+		ValidatorConfig:   config.ValidatorConfig{MaxBridgeData: 10},
+	}
+}
+
+func createConfigReloaderArgs(t *testing.T, cfg *config.ServerConfig) ArgsConfigReloader {
+	tlsReloader, err := NewTLSReloader(cfg.CertificateConfig)
+	require.Nil(t, err)
+	validator, err := NewBridgeOperationsValidator(cfg.ValidatorConfig, sha256.NewSha256())
+	require.Nil(t, err)
+	logStreamer, err := NewLogStreamer(&marshal.GogoProtoMarshalizer{}, cfg.LogWebSocket)
+	require.Nil(t, err)
+
+	return ArgsConfigReloader{
+		Config:        cfg,
+		TLSReloader:   tlsReloader,
+		Validator:     validator,
+		LogStreamer:   logStreamer,
+		ConfigUpdater: &adminMocks.SendingConfigUpdaterMock{},
+		Metrics:       metrics.NewPrometheusMetrics(),
+	}
+}
+
+func TestNewConfigReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config", func(t *testing.T) {
+		args := createConfigReloaderArgs(t, createReloadConfig(t))
+		args.Config = nil
+
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, errNilConfig, err)
+		require.Nil(t, cr)
+	})
+	t.Run("nil tls reloader", func(t *testing.T) {
+		args := createConfigReloaderArgs(t, createReloadConfig(t))
+		args.TLSReloader = nil
+
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, errNilTLSReloader, err)
+		require.Nil(t, cr)
+	})
+	t.Run("nil validator", func(t *testing.T) {
+		args := createConfigReloaderArgs(t, createReloadConfig(t))
+		args.Validator = nil
+
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, errNilValidator, err)
+		require.Nil(t, cr)
+	})
+	t.Run("nil log streamer", func(t *testing.T) {
+		args := createConfigReloaderArgs(t, createReloadConfig(t))
+		args.LogStreamer = nil
+
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, errNilLogStreamer, err)
+		require.Nil(t, cr)
+	})
+	t.Run("nil config updater", func(t *testing.T) {
+		args := createConfigReloaderArgs(t, createReloadConfig(t))
+		args.ConfigUpdater = nil
+
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, errNilConfigUpdater, err)
+		require.Nil(t, cr)
+	})
+	t.Run("nil metrics", func(t *testing.T) {
+		args := createConfigReloaderArgs(t, createReloadConfig(t))
+		args.Metrics = nil
+
+		cr, err := NewConfigReloader(args)
+		require.Equal(t, errNilMetricsHandler, err)
+		require.Nil(t, cr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		cr, err := NewConfigReloader(createConfigReloaderArgs(t, createReloadConfig(t)))
+		require.Nil(t, err)
+		require.False(t, cr.IsInterfaceNil())
+	})
+}
+
+func TestConfigReloader_Reload(t *testing.T) {
+	// not parallel, the log level is changed for all packages
+	defer func() {
+		_ = logger.SetLogLevel("*:" + logger.LogInfo.String())
+	}()
+
+	t.Run("should apply the reloadable fields and report the others", func(t *testing.T) {
+		cfg := createReloadConfig(t)
+		args := createConfigReloaderArgs(t, cfg)
+		var gasPatches []string
+		args.ConfigUpdater = &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+				require.Equal(t, reloadActor, actor)
+				gasPatches = append(gasPatches, string(patch))
+				return txSender.SendingConfig{}, nil
+			},
+		}
+		cr, _ := NewConfigReloader(args)
+
+		newCfg := *cfg
+		newCfg.LogLevel = "*:DEBUG"
+		newCfg.GRPCPort = "9000"
+		newCfg.TxSenderConfig.DcdtSafeSCAddress = "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7"
+		newCfg.TxSenderConfig.ExecuteGas.GasPriceMultiplier = 2
+		newCfg.CertificateConfig = createCertificateFiles(t, 60)
+		newCfg.ValidatorConfig.MaxBridgeData = 1
+		newCfg.LogWebSocket.MaxSubscribers = 2
+
+		result, err := cr.Reload(context.Background(), &newCfg, nil)
+		require.Nil(t, err)
+		require.Equal(t, []string{
+			"LogLevel",
+			"TxSenderConfig.ExecuteGas.GasPriceMultiplier",
+			"CertificateConfig.CertFile",
+			"CertificateConfig.PkFile",
+			"ValidatorConfig.MaxBridgeData",
+			"LogWebSocket.MaxSubscribers",
+		}, result.Applied)
+		require.Equal(t, []string{"GRPCPort", "TxSenderConfig.DcdtSafeSCAddress"}, result.NotApplied)
+
+		require.Equal(t, logger.LogDebug, log.GetLevel())
+		require.Equal(t, 1, args.Validator.(*bridgeOperationsValidator).cfg.Load().MaxBridgeData)
+		require.Equal(t, int64(2), args.LogStreamer.(*logStreamer).access.Load().maxSubscribers)
+
+		require.Len(t, gasPatches, 1)
+		patch := gasPatch{}
+		require.Nil(t, json.Unmarshal([]byte(gasPatches[0]), &patch))
+		require.Equal(t, gasPatch{
+			RegisterGas: txSender.GasConfig{GasLimit: 50_000_000, GasPriceMultiplier: 1},
+			ExecuteGas:  txSender.GasConfig{GasLimit: 50_000_000, GasPriceMultiplier: 2},
+		}, patch)
+
+		expiry, _ := args.TLSReloader.CertificateExpiry()
+		require.WithinDuration(t, time.Now().Add(60*24*time.Hour), expiry, time.Hour)
+
+		// changes which need a restart should be reported again, applied ones should not
+		result, err = cr.Reload(context.Background(), &newCfg, nil)
+		require.Nil(t, err)
+		require.Empty(t, result.Applied)
+		require.Equal(t, []string{"GRPCPort", "TxSenderConfig.DcdtSafeSCAddress"}, result.NotApplied)
+		require.Len(t, gasPatches, 1)
+	})
+	t.Run("masked fields should be reported as not applied", func(t *testing.T) {
+		cfg := createReloadConfig(t)
+		args := createConfigReloaderArgs(t, cfg)
+		cr, _ := NewConfigReloader(args)
+
+		newCfg := *cfg
+		newCfg.ValidatorConfig.MaxBridgeData = 1
+
+		result, err := cr.Reload(context.Background(), &newCfg, []string{"LogLevel", "TxSenderConfig.RegisterGas.GasLimit"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"ValidatorConfig.MaxBridgeData"}, result.Applied)
+		require.Equal(t, []string{"LogLevel", "TxSenderConfig.RegisterGas.GasLimit"}, result.NotApplied)
+	})
+	t.Run("invalid certificate should not apply any change", func(t *testing.T) {
+		cfg := createReloadConfig(t)
+		args := createConfigReloaderArgs(t, cfg)
+		cr, _ := NewConfigReloader(args)
+
+		newCfg := *cfg
+		newCfg.ValidatorConfig.MaxBridgeData = 1
+		newCfg.CertificateConfig = cert.FileCfg{CertFile: "missing.crt", PkFile: "missing.pem"}
+
+		result, err := cr.Reload(context.Background(), &newCfg, nil)
+		require.ErrorContains(t, err, "could not reload certificate missing.crt")
+		require.Empty(t, result.Applied)
+		require.Equal(t, 10, args.Validator.(*bridgeOperationsValidator).cfg.Load().MaxBridgeData)
+	})
+	t.Run("invalid gas should not apply any change", func(t *testing.T) {
+		cfg := createReloadConfig(t)
+		args := createConfigReloaderArgs(t, cfg)
+		args.ConfigUpdater = &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+				require.Fail(t, "invalid gas settings should not be sent")
+				return txSender.SendingConfig{}, nil
+			},
+		}
+		cr, _ := NewConfigReloader(args)
+
+		newCfg := *cfg
+		newCfg.CertificateConfig = createCertificateFiles(t, 60)
+		newCfg.ValidatorConfig.MaxBridgeData = 1
+		newCfg.TxSenderConfig.ExecuteGas.GasPriceMultiplier = 20
+
+		result, err := cr.Reload(context.Background(), &newCfg, nil)
+		require.ErrorContains(t, err, "invalid execute gas")
+		require.Empty(t, result.Applied)
+		require.Equal(t, 10, args.Validator.(*bridgeOperationsValidator).cfg.Load().MaxBridgeData)
+
+		expiry, _ := args.TLSReloader.CertificateExpiry()
+		require.WithinDuration(t, time.Now().Add(30*24*time.Hour), expiry, time.Hour)
+	})
+	t.Run("gas update error should not apply any change", func(t *testing.T) {
+		cfg := createReloadConfig(t)
+		args := createConfigReloaderArgs(t, cfg)
+		errUpdate := errors.New("audit log error")
+		args.ConfigUpdater = &adminMocks.SendingConfigUpdaterMock{
+			UpdateSendingConfigCalled: func(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+				return txSender.SendingConfig{}, errUpdate
+			},
+		}
+		cr, _ := NewConfigReloader(args)
+
+		newCfg := *cfg
+		newCfg.CertificateConfig = createCertificateFiles(t, 60)
+		newCfg.TxSenderConfig.RegisterGas.GasLimit = 60_000_000
+		newCfg.LogWebSocket.MaxSubscribers = 2
+
+		result, err := cr.Reload(context.Background(), &newCfg, nil)
+		require.ErrorIs(t, err, errUpdate)
+		require.Empty(t, result.Applied)
+		require.Equal(t, int64(defaultMaxLogSubscribers), args.LogStreamer.(*logStreamer).access.Load().maxSubscribers)
+
+		expiry, _ := args.TLSReloader.CertificateExpiry()
+		require.WithinDuration(t, time.Now().Add(30*24*time.Hour), expiry, time.Hour)
+
+		// the failed changes should be applied by the next reload
+		args.ConfigUpdater.(*adminMocks.SendingConfigUpdaterMock).UpdateSendingConfigCalled = nil
+		result, err = cr.Reload(context.Background(), &newCfg, nil)
+		require.Nil(t, err)
+		require.Equal(t, []string{
+			"TxSenderConfig.RegisterGas.GasLimit",
+			"CertificateConfig.CertFile",
+			"CertificateConfig.PkFile",
+			"LogWebSocket.MaxSubscribers",
+		}, result.Applied)
+	})
+}
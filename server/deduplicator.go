@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	idempotencyKeyTTL = 10 * time.Minute
+)
+
+type dedupEntry struct {
+	done   chan struct{}
+	hashes []string
+	err    error
+	expiry time.Time
+}
+
+// deduplicator makes sure that requests retried with the same idempotency key are only processed once. Keyed requests
+// are sent on a context detached from the caller, so that a caller which stops waiting, e.g. on its deadline, does not
+// abort a batch which may already have nonces assigned. The detached send is still bounded by sendTimeout, so that a
+// stuck proxy does not hold the key and its waiters forever. The key is kept until the send result is final, so retries
+// and concurrent requests with the same key wait for it, as long as their own context allows, and receive its result.
+// Failed requests are not cached, so that they can be retried.
+type deduplicator struct {
+	mut         sync.Mutex
+	entries     map[string]*dedupEntry
+	ttl         time.Duration
+	sendTimeout time.Duration
+	lastSweep   time.Time
+}
+
+func newDeduplicator(ttl time.Duration, sendTimeout time.Duration) *deduplicator {
+	return &deduplicator{
+		entries:     make(map[string]*dedupEntry),
+		ttl:         ttl,
+		sendTimeout: sendTimeout,
+		lastSweep:   time.Now(),
+	}
+}
+
+func (d *deduplicator) do(ctx context.Context, key string, send func(ctx context.Context) ([]string, error)) ([]string, error) {
+	if len(key) == 0 {
+		return send(ctx)
+	}
+
+	entry, isNew := d.getOrAdd(key)
+	if isNew {
+		go d.sendDetached(ctx, key, entry, send)
+	}
+
+	select {
+	case <-entry.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if !isNew {
+		log.Debug("deduplicated bridge operations request", "idempotency key", key)
+	}
+
+	return entry.hashes, entry.err
+}
+
+func (d *deduplicator) sendDetached(ctx context.Context, key string, entry *dedupEntry, send func(ctx context.Context) ([]string, error)) {
+	sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.sendTimeout)
+	entry.hashes, entry.err = send(sendCtx)
+	cancel()
+
+	d.mut.Lock()
+	if entry.err != nil {
+		delete(d.entries, key)
+	} else {
+		entry.expiry = time.Now().Add(d.ttl)
+	}
+	d.mut.Unlock()
+
+	close(entry.done)
+}
+
+func (d *deduplicator) getOrAdd(key string) (*dedupEntry, bool) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	now := time.Now()
+	d.sweepExpired(now)
+
+	entry, found := d.entries[key]
+	if found && (entry.expiry.IsZero() || entry.expiry.After(now)) {
+		return entry, false
+	}
+
+	entry = &dedupEntry{
+		done: make(chan struct{}),
+	}
+	d.entries[key] = entry
+
+	return entry, true
+}
+
+func (d *deduplicator) sweepExpired(now time.Time) {
+	if now.Sub(d.lastSweep) < d.ttl {
+		return
+	}
+
+	for key, entry := range d.entries {
+		if !entry.expiry.IsZero() && !entry.expiry.After(now) {
+			delete(d.entries, key)
+		}
+	}
+	d.lastSweep = now
+}
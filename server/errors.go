@@ -9,3 +9,37 @@ var errNilMarshaller = errors.New("nil marshaller provided")
 var errNilGinHandler = errors.New("nil gin handler provided")
 
 var errNilGRPCHandler = errors.New("nil grpc handler provided")
+
+var errNilValidator = errors.New("nil validator provided")
+
+var errNilLatencyRecorder = errors.New("nil latency recorder provided")
+
+var errNilBridgeMetrics = errors.New("nil bridge metrics provided")
+
+var errNilMetricsHandler = errors.New("nil metrics handler provided")
+
+var errInvalidMinWalletBalance = errors.New("invalid min wallet balance provided")
+
+var errNilHealthMonitor = errors.New("nil health monitor provided")
+
+var errNilTxStatsProvider = errors.New("nil tx stats provider provided")
+
+var errNilStatusProvider = errors.New("nil status provider provided")
+
+var errNilBridgeServer = errors.New("nil bridge server provided")
+
+var errNilAdminController = errors.New("nil admin controller provided")
+
+var errNilSendingConfigProvider = errors.New("nil sending config provider provided")
+
+var errNilConfigUpdater = errors.New("nil config updater provided")
+
+var errNilConfig = errors.New("nil config provided")
+
+var errNilTLSReloader = errors.New("nil tls reloader provided")
+
+var errNoServerCertificate = errors.New("no server certificate loaded")
+
+var errNilLogStreamer = errors.New("nil log streamer provided")
+
+var errInvalidSendTimeout = errors.New("invalid send timeout provided")
@@ -1,22 +1,176 @@
 package server
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/admin"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/metrics"
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
 )
 
-// CreateSovereignBridgeServer creates a new bridge txs sender grpc server
-func CreateSovereignBridgeServer(cfg *config.ServerConfig) (sovereign.BridgeTxSenderServer, error) {
+const (
+	defaultHealthCheckIntervalInSec = 10
+	defaultHealthCheckTimeoutInSec  = 5
+	defaultSendTimeoutInSec         = 60
+	defaultAdminStateFile           = "bridge_state.json"
+	defaultAdminAuditLogFile        = "admin_audit.log"
+)
+
+// Components holds the bridge server and the components managed alongside it by the server binary
+type Components struct {
+	BridgeServer  sovereign.BridgeTxSenderServer
+	Validator     ReloadableValidator
+	TxSender      TxSenderHandler
+	HealthMonitor HealthMonitor
+	Admin         AdminController
+	ConfigUpdater SendingConfigUpdater
+	Metrics       MetricsHandler
+	WalletAddress string
+}
+
+// CreateComponents creates the bridge txs sender grpc server, its health monitor, the admin controller, the sending
+// config updater and the metrics updated by all components. The persisted pause state is restored, health checks are not started.
+// The tx sender and the health monitor should be closed by the caller.
+func CreateComponents(cfg *config.ServerConfig) (*Components, error) {
 	wallet, err := txSender.LoadWallet(cfg.WalletConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	txSnd, err := txSender.CreateTxSender(wallet, cfg.TxSenderConfig)
+	hasher, err := factory.NewHasher(cfg.TxSenderConfig.Hasher)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewSovereignBridgeTxServer(txSnd)
+	validator, err := NewBridgeOperationsValidator(cfg.ValidatorConfig, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeMetrics := metrics.NewPrometheusMetrics()
+	proxy, err := txSender.CreateProxy(cfg.TxSenderConfig, bridgeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	healthMonitor, err := createHealthMonitor(cfg.HealthConfig, proxy, wallet.GetAddressHandler(), bridgeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	txSnd, err := txSender.CreateTxSender(wallet, proxy, cfg.TxSenderConfig, bridgeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	adminController, err := admin.NewAdminController(admin.ArgsAdminController{
+		TxSender:      txSnd,
+		HealthMonitor: healthMonitor,
+		StateFilePath: getAdminStateFile(cfg.AdminConfig),
+	})
+	if err != nil {
+		return nil, closeOnError(txSnd, err)
+	}
+
+	configUpdater, err := admin.NewConfigUpdater(admin.ArgsConfigUpdater{
+		TxSender:     txSnd,
+		Proxy:        proxy,
+		AuditLogPath: getAdminAuditLogFile(cfg.AdminConfig),
+	})
+	if err != nil {
+		return nil, closeOnError(txSnd, err)
+	}
+
+	bridgeServer, err := NewSovereignBridgeTxServer(adminController, validator, getSendTimeout(cfg.SendTimeoutInSec))
+	if err != nil {
+		return nil, closeOnError(txSnd, err)
+	}
+
+	return &Components{
+		BridgeServer:  bridgeServer,
+		Validator:     validator,
+		TxSender:      txSnd,
+		HealthMonitor: healthMonitor,
+		Admin:         adminController,
+		ConfigUpdater: configUpdater,
+		Metrics:       bridgeMetrics,
+		WalletAddress: wallet.GetBech32(),
+	}, nil
+}
+
+// closeOnError closes the tx sender, stopping its goroutines, when the components can not be created
+func closeOnError(txSnd TxSenderHandler, err error) error {
+	log.LogIfError(txSnd.Close())
+	return err
+}
+
+func getSendTimeout(sendTimeoutInSec int) time.Duration {
+	if sendTimeoutInSec <= 0 {
+		return defaultSendTimeoutInSec * time.Second
+	}
+
+	return time.Duration(sendTimeoutInSec) * time.Second
+}
+
+func createHealthMonitor(cfg config.HealthConfig, proxy health.Proxy, walletAddress core.AddressHandler, healthMetrics health.Metrics) (HealthMonitor, error) {
+	checkInterval := cfg.CheckIntervalInSec
+	if checkInterval <= 0 {
+		checkInterval = defaultHealthCheckIntervalInSec
+	}
+	checkTimeout := cfg.CheckTimeoutInSec
+	if checkTimeout <= 0 {
+		checkTimeout = defaultHealthCheckTimeoutInSec
+	}
+
+	minWalletBalance, err := chain.ParseMinBalance(cfg.MinWalletBalance)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidMinWalletBalance, err)
+	}
+
+	return health.NewHealthMonitor(health.ArgsHealthMonitor{
+		Proxy:            proxy,
+		WalletAddress:    walletAddress,
+		MinWalletBalance: minWalletBalance,
+		CheckInterval:    time.Second * time.Duration(checkInterval),
+		CheckTimeout:     time.Second * time.Duration(checkTimeout),
+		Metrics:          healthMetrics,
+	})
+}
+
+func getAdminStateFile(cfg config.AdminConfig) string {
+	if len(cfg.StateFile) == 0 {
+		return defaultAdminStateFile
+	}
+
+	return cfg.StateFile
+}
+
+// RegisterServices registers the bridge service and the grpc health service on the grpc server. The reflection
+// service is registered only if enabled.
+func RegisterServices(grpcServer *grpc.Server, components *Components, cfg config.HealthConfig) {
+	sovereign.RegisterBridgeTxSenderServer(grpcServer, components.BridgeServer)
+	grpc_health_v1.RegisterHealthServer(grpcServer, components.HealthMonitor.HealthServer())
+
+	if cfg.EnableReflection {
+		reflection.Register(grpcServer)
+	}
+}
+
+func getAdminAuditLogFile(cfg config.AdminConfig) string {
+	if len(cfg.AuditLogFile) == 0 {
+		return defaultAdminAuditLogFile
+	}
+
+	return cfg.AuditLogFile
 }
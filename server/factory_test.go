@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/admin"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testkit/fakeProxy"
+)
+
+const (
+	alicePemPath = "txSender/testData/alice.pem"
+	bobAddress   = "drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"
+)
+
+func createTestServerConfig(t *testing.T, proxyURL string) *config.ServerConfig {
+	return &config.ServerConfig{
+		TxSenderConfig: txSender.TxSenderConfig{
+			HeaderVerifierSCAddress: bobAddress,
+			DcdtSafeSCAddress:       bobAddress,
+			Proxy:                   proxyURL,
+			IntervalToSend:          1,
+			Hasher:                  "sha256",
+			NumWorkers:              1,
+		},
+		WalletConfig: txSender.WalletConfig{
+			Path: alicePemPath,
+		},
+		HealthConfig: config.HealthConfig{
+			EnableReflection: true,
+		},
+		AdminConfig: config.AdminConfig{
+			StateFile:    filepath.Join(t.TempDir(), "state.json"),
+			AuditLogFile: filepath.Join(t.TempDir(), "audit.log"),
+		},
+	}
+}
+
+func serve(t *testing.T, components *Components, cfg config.HealthConfig) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	RegisterServices(grpcServer, components, cfg)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func TestCreateComponents(t *testing.T) {
+	t.Parallel()
+
+	httpServer := httptest.NewServer(fakeProxy.NewFakeProxy(fakeProxy.ArgsFakeProxy{}))
+	defer httpServer.Close()
+
+	t.Run("invalid min wallet balance", func(t *testing.T) {
+		cfg := createTestServerConfig(t, httpServer.URL)
+		cfg.HealthConfig.MinWalletBalance = "one"
+
+		components, err := CreateComponents(cfg)
+		require.ErrorIs(t, err, errInvalidMinWalletBalance)
+		require.Nil(t, components)
+	})
+	t.Run("health service should report readiness", func(t *testing.T) {
+		cfg := createTestServerConfig(t, httpServer.URL)
+		components, err := CreateComponents(cfg)
+		require.Nil(t, err)
+		defer func() {
+			require.Nil(t, components.HealthMonitor.Close())
+			require.Nil(t, components.TxSender.Close())
+		}()
+
+		conn := serve(t, components, cfg.HealthConfig)
+		healthClient := grpc_health_v1.NewHealthClient(conn)
+
+		res, err := healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: health.BridgeServiceName})
+		require.Nil(t, err)
+		require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.Status)
+
+		components.HealthMonitor.StartChecks()
+		require.Eventually(t, func() bool {
+			res, err = healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: health.BridgeServiceName})
+			return err == nil && res.Status == grpc_health_v1.HealthCheckResponse_SERVING
+		}, time.Second*5, time.Millisecond*10)
+
+		components.HealthMonitor.SetPaused(true)
+		res, err = healthClient.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.Nil(t, err)
+		require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.Status)
+	})
+	t.Run("paused state should be restored", func(t *testing.T) {
+		cfg := createTestServerConfig(t, httpServer.URL)
+		components, err := CreateComponents(cfg)
+		require.Nil(t, err)
+
+		_, err = components.Admin.Pause(admin.PauseModeReject)
+		require.Nil(t, err)
+		require.Nil(t, components.TxSender.Close())
+
+		components, err = CreateComponents(cfg)
+		require.Nil(t, err)
+		defer func() {
+			require.Nil(t, components.TxSender.Close())
+		}()
+
+		require.Equal(t, admin.StatePaused, components.Admin.Status().State)
+		require.True(t, components.HealthMonitor.Status().Paused)
+
+		_, err = components.BridgeServer.Send(context.Background(), &sovereign.BridgeOperations{})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("reflection should list services if enabled", func(t *testing.T) {
+		cfg := createTestServerConfig(t, httpServer.URL)
+		components, err := CreateComponents(cfg)
+		require.Nil(t, err)
+		defer func() {
+			require.Nil(t, components.TxSender.Close())
+		}()
+
+		conn := serve(t, components, cfg.HealthConfig)
+		stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+		require.Nil(t, err)
+
+		err = stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+			MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{},
+		})
+		require.Nil(t, err)
+		res, err := stream.Recv()
+		require.Nil(t, err)
+
+		services := make([]string, 0)
+		for _, service := range res.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		require.Contains(t, services, health.BridgeServiceName)
+		require.Contains(t, services, grpc_health_v1.Health_ServiceDesc.ServiceName)
+	})
+}
@@ -4,44 +4,80 @@ import (
 	"net/http"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
-	"github.com/TerraDharitri/drt-go-chain-core/marshal"
-	"github.com/TerraDharitri/drt-go-chain/api/logs"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	"github.com/gin-gonic/gin"
-	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
 )
 
-// NewGinHandler will create a gin handler
-func NewGinHandler(marshaller marshal.Marshalizer) (*gin.Engine, error) {
-	if check.IfNilReflect(marshaller) {
-		return nil, errNilMarshaller
+// ArgsGinHandler holds the arguments needed to create the gin handler
+type ArgsGinHandler struct {
+	LogStreamer    LogStreamer
+	MetricsHandler http.Handler
+	StatusProvider StatusProvider
+	BridgeServer   sovereign.BridgeTxSenderServer
+	Interceptors   []grpc.UnaryServerInterceptor
+	Admin          AdminController
+	ConfigUpdater  SendingConfigUpdater
+	AdminAuthToken string
+}
+
+// NewGinHandler will create a gin handler, serving the authenticated logs websocket, the prometheus metrics, the server
+// health, readiness and status endpoints, the REST bridge operations endpoint and the admin api. The interceptors should
+// be the ones used by the grpc server, so that REST requests are handled the same way.
+func NewGinHandler(args ArgsGinHandler) (*gin.Engine, error) {
+	if check.IfNil(args.LogStreamer) {
+		return nil, errNilLogStreamer
+	}
+	if check.IfNilReflect(args.MetricsHandler) {
+		return nil, errNilMetricsHandler
+	}
+	if check.IfNil(args.StatusProvider) {
+		return nil, errNilStatusProvider
+	}
+	if check.IfNilReflect(args.BridgeServer) {
+		return nil, errNilBridgeServer
+	}
+	if check.IfNil(args.Admin) {
+		return nil, errNilAdminController
+	}
+	if check.IfNil(args.ConfigUpdater) {
+		return nil, errNilConfigUpdater
 	}
 
 	router := gin.Default()
-	registerLoggerWsRoute(router, marshaller)
+	// the server is reached directly, so forwarding headers are not trusted when resolving the client ip
+	err := router.SetTrustedProxies(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	registerLoggerWsRoute(router, args.LogStreamer)
+	registerStatusRoutes(router, args.StatusProvider)
+	router.GET("/metrics", gin.WrapH(args.MetricsHandler))
+	registerBridgeOperationsRoute(router, args.BridgeServer, args.Interceptors)
+	registerAdminRoutes(router, args.Admin, args.ConfigUpdater, args.AdminAuthToken)
 
 	return router, nil
 }
 
-func registerLoggerWsRoute(ws *gin.Engine, marshaller marshal.Marshalizer) {
-	upgrader := websocket.Upgrader{}
-
-	ws.GET("/log", func(c *gin.Context) {
-		upgrader.CheckOrigin = func(r *http.Request) bool {
-			return true
-		}
+// registerStatusRoutes registers the liveness, readiness and status summary endpoints. The readiness endpoint
+// responds with service unavailable while the server is not ready.
+func registerStatusRoutes(router *gin.Engine, statusProvider StatusProvider) {
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "alive"})
+	})
 
-		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
-		if err != nil {
-			log.Error(err.Error())
+	router.GET("/ready", func(c *gin.Context) {
+		readiness := statusProvider.Readiness()
+		if !readiness.Ready {
+			c.JSON(http.StatusServiceUnavailable, readiness)
 			return
 		}
 
-		ls, err := logs.NewLogSender(marshaller, conn, log)
-		if err != nil {
-			log.Error(err.Error())
-			return
-		}
+		c.JSON(http.StatusOK, readiness)
+	})
 
-		ls.StartSendingBlocking()
+	router.GET("/status", func(c *gin.Context) {
+		c.JSON(http.StatusOK, statusProvider.Status())
 	})
 }
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/adminMocks"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/serverMocks"
+)
+
+func createArgsGinHandler() ArgsGinHandler {
+	statusProvider, _ := NewStatusProvider(createArgsStatusProvider())
+	logStreamer, _ := NewLogStreamer(&marshal.GogoProtoMarshalizer{}, config.LogWebSocketConfig{})
+
+	return ArgsGinHandler{
+		LogStreamer: logStreamer,
+		MetricsHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("sovereign_bridge_queue_depth 0"))
+		}),
+		StatusProvider: statusProvider,
+		BridgeServer:   &testscommon.MockBridgeTxSenderServer{},
+		Admin:          &adminMocks.AdminControllerMock{},
+		ConfigUpdater:  &adminMocks.SendingConfigUpdaterMock{},
+	}
+}
+
+func serveGinRequest(t *testing.T, args ArgsGinHandler, path string) *httptest.ResponseRecorder {
+	handler, err := NewGinHandler(args)
+	require.Nil(t, err)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return w
+}
+
+func TestNewGinHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil log streamer", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.LogStreamer = nil
+
+		handler, err := NewGinHandler(args)
+		require.Equal(t, errNilLogStreamer, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil metrics handler", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.MetricsHandler = nil
+
+		handler, err := NewGinHandler(args)
+		require.Equal(t, errNilMetricsHandler, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil status provider", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.StatusProvider = nil
+
+		handler, err := NewGinHandler(args)
+		require.Equal(t, errNilStatusProvider, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil bridge server", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.BridgeServer = nil
+
+		handler, err := NewGinHandler(args)
+		require.Equal(t, errNilBridgeServer, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil admin controller", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.Admin = nil
+
+		handler, err := NewGinHandler(args)
+		require.Equal(t, errNilAdminController, err)
+		require.Nil(t, handler)
+	})
+	t.Run("nil config updater", func(t *testing.T) {
+		args := createArgsGinHandler()
+		args.ConfigUpdater = nil
+
+		handler, err := NewGinHandler(args)
+		require.Equal(t, errNilConfigUpdater, err)
+		require.Nil(t, handler)
+	})
+}
+
+func TestGinHandler_Routes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("metrics", func(t *testing.T) {
+		w := serveGinRequest(t, createArgsGinHandler(), "/metrics")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "sovereign_bridge_queue_depth 0", w.Body.String())
+	})
+	t.Run("health", func(t *testing.T) {
+		w := serveGinRequest(t, createArgsGinHandler(), "/health")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"status":"alive"}`, w.Body.String())
+	})
+	t.Run("ready", func(t *testing.T) {
+		w := serveGinRequest(t, createArgsGinHandler(), "/ready")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		readiness := health.Status{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &readiness))
+		require.True(t, readiness.Ready)
+	})
+	t.Run("not ready", func(t *testing.T) {
+		argsStatusProvider := createArgsStatusProvider()
+		argsStatusProvider.HealthMonitor = &serverMocks.HealthMonitorMock{
+			StatusCalled: func() health.Status {
+				return health.Status{ProxyReachable: true, Error: "insufficient wallet balance"}
+			},
+		}
+		args := createArgsGinHandler()
+		args.StatusProvider, _ = NewStatusProvider(argsStatusProvider)
+
+		w := serveGinRequest(t, args, "/ready")
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+		readiness := health.Status{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &readiness))
+		require.False(t, readiness.Ready)
+		require.True(t, readiness.ProxyReachable)
+		require.Equal(t, "insufficient wallet balance", readiness.Error)
+	})
+	t.Run("status", func(t *testing.T) {
+		w := serveGinRequest(t, createArgsGinHandler(), "/status")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		status := BridgeStatus{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), &status))
+		require.Equal(t, "v1.0.0", status.Version)
+		require.Equal(t, "T", status.ChainID)
+		require.Equal(t, "txHash", status.LastSentTx.Hash)
+		require.Equal(t, 3, status.PendingTxs)
+	})
+}
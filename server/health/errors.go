@@ -0,0 +1,15 @@
+package health
+
+import "errors"
+
+var errNilProxy = errors.New("nil proxy provided")
+
+var errNilWalletAddress = errors.New("nil wallet address provided")
+
+var errNilMetrics = errors.New("nil metrics provided")
+
+var errInvalidCheckInterval = errors.New("invalid check interval provided")
+
+var errInvalidMinWalletBalance = errors.New("invalid min wallet balance provided")
+
+var errEmptyChainID = errors.New("empty chain id in network config")
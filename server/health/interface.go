@@ -0,0 +1,22 @@
+package health
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// Proxy defines the proxy calls used to check the bridge server readiness
+type Proxy interface {
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	IsInterfaceNil() bool
+}
+
+// Metrics defines the metrics updated by the health monitor
+type Metrics interface {
+	SetWalletBalance(balance *big.Int)
+	IsInterfaceNil() bool
+}
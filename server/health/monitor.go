@@ -0,0 +1,262 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+)
+
+var log = logger.GetOrCreate("health")
+
+// BridgeServiceName is the name of the bridge grpc service, as checked by health clients
+const BridgeServiceName = "sovereign.BridgeTxSender"
+
+// Status holds the result of the last readiness check
+type Status struct {
+	Ready               bool      `json:"ready"`
+	ProxyReachable      bool      `json:"proxyReachable"`
+	NetworkConfigLoaded bool      `json:"networkConfigLoaded"`
+	WalletFunded        bool      `json:"walletFunded"`
+	Paused              bool      `json:"paused"`
+	ChainID             string    `json:"chainID,omitempty"`
+	WalletBalance       string    `json:"walletBalance"`
+	Error               string    `json:"error,omitempty"`
+	LastCheck           time.Time `json:"lastCheck"`
+}
+
+// ArgsHealthMonitor holds the arguments needed to create a health monitor
+type ArgsHealthMonitor struct {
+	Proxy            Proxy
+	WalletAddress    core.AddressHandler
+	MinWalletBalance *big.Int
+	CheckInterval    time.Duration
+	CheckTimeout     time.Duration
+	Metrics          Metrics
+}
+
+type healthMonitor struct {
+	proxy            Proxy
+	walletAddress    core.AddressHandler
+	minWalletBalance *big.Int
+	checkInterval    time.Duration
+	checkTimeout     time.Duration
+	metrics          Metrics
+	healthServer     *health.Server
+
+	mutStatus sync.RWMutex
+	status    Status
+	paused    bool
+
+	startOnce sync.Once
+	closeOnce sync.Once
+	cancel    func()
+	wg        sync.WaitGroup
+}
+
+// NewHealthMonitor creates a monitor which periodically checks the bridge server readiness and reports it through the
+// standard grpc health service. The server is reported as serving only if the proxy is reachable, the network config
+// is loaded, the wallet is funded and the server is not paused. Until the first check, the server is not serving.
+func NewHealthMonitor(args ArgsHealthMonitor) (*healthMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	hm := &healthMonitor{
+		proxy:            args.Proxy,
+		walletAddress:    args.WalletAddress,
+		minWalletBalance: args.MinWalletBalance,
+		checkInterval:    args.CheckInterval,
+		checkTimeout:     args.CheckTimeout,
+		metrics:          args.Metrics,
+		healthServer:     health.NewServer(),
+		cancel:           func() {},
+	}
+	hm.setServingStatus(false)
+
+	return hm, nil
+}
+
+func checkArgs(args ArgsHealthMonitor) error {
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if check.IfNil(args.WalletAddress) {
+		return errNilWalletAddress
+	}
+	if check.IfNil(args.Metrics) {
+		return errNilMetrics
+	}
+	if args.CheckInterval <= 0 || args.CheckTimeout <= 0 {
+		return fmt.Errorf("%w: interval %v, timeout %v", errInvalidCheckInterval, args.CheckInterval, args.CheckTimeout)
+	}
+	if args.MinWalletBalance == nil || args.MinWalletBalance.Sign() < 0 {
+		return fmt.Errorf("%w: %v", errInvalidMinWalletBalance, args.MinWalletBalance)
+	}
+
+	return nil
+}
+
+// StartChecks runs the readiness checks every check interval, until the monitor is closed
+func (hm *healthMonitor) StartChecks() {
+	hm.startOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		hm.mutStatus.Lock()
+		hm.cancel = cancel
+		hm.mutStatus.Unlock()
+
+		hm.wg.Add(1)
+		go hm.checkLoop(ctx)
+	})
+}
+
+func (hm *healthMonitor) checkLoop(ctx context.Context) {
+	defer hm.wg.Done()
+
+	ticker := time.NewTicker(hm.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		hm.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs the readiness checks, updating the health service status
+func (hm *healthMonitor) Check(ctx context.Context) Status {
+	ctx, cancel := context.WithTimeout(ctx, hm.checkTimeout)
+	defer cancel()
+
+	status := Status{
+		LastCheck: time.Now(),
+	}
+	err := hm.checkNetworkConfig(ctx, &status)
+	if err == nil {
+		err = hm.checkWallet(ctx, &status)
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	hm.mutStatus.Lock()
+	status.Paused = hm.paused
+	hm.status = status
+	hm.updateReadiness()
+	status = hm.status
+	hm.mutStatus.Unlock()
+
+	if !status.Ready {
+		log.Debug("bridge server is not ready", "status", fmt.Sprintf("%+v", status))
+	}
+
+	return status
+}
+
+func (hm *healthMonitor) checkNetworkConfig(ctx context.Context, status *Status) error {
+	networkConfig, err := hm.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get network config: %w", err)
+	}
+	if networkConfig == nil || len(networkConfig.ChainID) == 0 {
+		return errEmptyChainID
+	}
+
+	status.ChainID = networkConfig.ChainID
+	status.NetworkConfigLoaded = true
+	return nil
+}
+
+func (hm *healthMonitor) checkWallet(ctx context.Context, status *Status) error {
+	account, err := hm.proxy.GetAccount(ctx, hm.walletAddress)
+	if err != nil {
+		return fmt.Errorf("could not get wallet account: %w", err)
+	}
+
+	status.ProxyReachable = true
+	status.WalletBalance = account.Balance
+
+	balance, err := chain.CheckBalance(account.Balance, hm.minWalletBalance)
+	if balance != nil {
+		hm.metrics.SetWalletBalance(balance)
+	}
+	if err != nil {
+		return err
+	}
+
+	status.WalletFunded = true
+	return nil
+}
+
+// SetPaused marks the server as paused, or resumed, reporting it as not serving while paused
+func (hm *healthMonitor) SetPaused(paused bool) {
+	hm.mutStatus.Lock()
+	defer hm.mutStatus.Unlock()
+
+	hm.paused = paused
+	hm.status.Paused = paused
+	hm.updateReadiness()
+}
+
+// updateReadiness should be called under mutex
+func (hm *healthMonitor) updateReadiness() {
+	status := &hm.status
+	status.Ready = status.ProxyReachable && status.NetworkConfigLoaded && status.WalletFunded && !status.Paused
+	hm.setServingStatus(status.Ready)
+}
+
+func (hm *healthMonitor) setServingStatus(isServing bool) {
+	servingStatus := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if isServing {
+		servingStatus = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	hm.healthServer.SetServingStatus("", servingStatus)
+	hm.healthServer.SetServingStatus(BridgeServiceName, servingStatus)
+}
+
+// Status returns the result of the last readiness check
+func (hm *healthMonitor) Status() Status {
+	hm.mutStatus.RLock()
+	defer hm.mutStatus.RUnlock()
+
+	return hm.status
+}
+
+// HealthServer returns the grpc health service, to be registered on the grpc server
+func (hm *healthMonitor) HealthServer() grpc_health_v1.HealthServer {
+	return hm.healthServer
+}
+
+// Close stops the checks and reports the server as not serving to all health watchers
+func (hm *healthMonitor) Close() error {
+	hm.closeOnce.Do(func() {
+		hm.mutStatus.Lock()
+		hm.cancel()
+		hm.mutStatus.Unlock()
+
+		hm.wg.Wait()
+		hm.healthServer.Shutdown()
+	})
+
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (hm *healthMonitor) IsInterfaceNil() bool {
+	return hm == nil
+}
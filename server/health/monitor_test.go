@@ -0,0 +1,206 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+const walletAddress = "drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"
+
+func createArgs(proxy Proxy) ArgsHealthMonitor {
+	address, _ := data.NewAddressFromBech32String(walletAddress)
+
+	return ArgsHealthMonitor{
+		Proxy:            proxy,
+		WalletAddress:    address,
+		MinWalletBalance: big.NewInt(100),
+		CheckInterval:    time.Millisecond * 10,
+		CheckTimeout:     time.Second,
+		Metrics:          &testscommon.HealthMetricsMock{},
+	}
+}
+
+func createHealthyProxy() *testscommon.ProxyMock {
+	return &testscommon.ProxyMock{
+		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{ChainID: "T"}, nil
+		},
+		GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Balance: "1000"}, nil
+		},
+	}
+}
+
+func requireServingStatus(t *testing.T, hm *healthMonitor, expected grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	for _, service := range []string{"", BridgeServiceName} {
+		res, err := hm.HealthServer().Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.Nil(t, err)
+		require.Equal(t, expected, res.Status)
+	}
+}
+
+func TestNewHealthMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy", func(t *testing.T) {
+		hm, err := NewHealthMonitor(createArgs(nil))
+		require.Equal(t, errNilProxy, err)
+		require.Nil(t, hm)
+	})
+	t.Run("nil wallet address", func(t *testing.T) {
+		args := createArgs(createHealthyProxy())
+		args.WalletAddress = nil
+		hm, err := NewHealthMonitor(args)
+		require.Equal(t, errNilWalletAddress, err)
+		require.Nil(t, hm)
+	})
+	t.Run("nil metrics", func(t *testing.T) {
+		args := createArgs(createHealthyProxy())
+		args.Metrics = nil
+		hm, err := NewHealthMonitor(args)
+		require.Equal(t, errNilMetrics, err)
+		require.Nil(t, hm)
+	})
+	t.Run("invalid check interval", func(t *testing.T) {
+		args := createArgs(createHealthyProxy())
+		args.CheckInterval = 0
+		hm, err := NewHealthMonitor(args)
+		require.ErrorIs(t, err, errInvalidCheckInterval)
+		require.Nil(t, hm)
+	})
+	t.Run("invalid min wallet balance", func(t *testing.T) {
+		args := createArgs(createHealthyProxy())
+		args.MinWalletBalance = big.NewInt(-1)
+		hm, err := NewHealthMonitor(args)
+		require.ErrorIs(t, err, errInvalidMinWalletBalance)
+		require.Nil(t, hm)
+	})
+	t.Run("should not serve before the first check", func(t *testing.T) {
+		hm, err := NewHealthMonitor(createArgs(createHealthyProxy()))
+		require.Nil(t, err)
+		require.False(t, hm.IsInterfaceNil())
+		require.False(t, hm.Status().Ready)
+		requireServingStatus(t, hm, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	})
+}
+
+func TestHealthMonitor_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all checks pass", func(t *testing.T) {
+		var walletBalance *big.Int
+		args := createArgs(createHealthyProxy())
+		args.Metrics = &testscommon.HealthMetricsMock{
+			SetWalletBalanceCalled: func(balance *big.Int) {
+				walletBalance = balance
+			},
+		}
+		hm, _ := NewHealthMonitor(args)
+
+		status := hm.Check(context.Background())
+		require.True(t, status.Ready)
+		require.True(t, status.ProxyReachable)
+		require.True(t, status.NetworkConfigLoaded)
+		require.True(t, status.WalletFunded)
+		require.Equal(t, "1000", status.WalletBalance)
+		require.Equal(t, big.NewInt(1000), walletBalance)
+		require.Equal(t, "T", status.ChainID)
+		require.Empty(t, status.Error)
+		require.Equal(t, status, hm.Status())
+		requireServingStatus(t, hm, grpc_health_v1.HealthCheckResponse_SERVING)
+	})
+	t.Run("proxy unreachable", func(t *testing.T) {
+		proxy := createHealthyProxy()
+		proxy.GetNetworkConfigCalled = func(ctx context.Context) (*data.NetworkConfig, error) {
+			return nil, errors.New("connection refused")
+		}
+		proxy.GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			return nil, errors.New("connection refused")
+		}
+		hm, _ := NewHealthMonitor(createArgs(proxy))
+
+		status := hm.Check(context.Background())
+		require.False(t, status.Ready)
+		require.False(t, status.ProxyReachable)
+		require.False(t, status.NetworkConfigLoaded)
+		require.Contains(t, status.Error, "connection refused")
+		requireServingStatus(t, hm, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	})
+	t.Run("network config not loaded", func(t *testing.T) {
+		proxy := createHealthyProxy()
+		proxy.GetNetworkConfigCalled = func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{}, nil
+		}
+		hm, _ := NewHealthMonitor(createArgs(proxy))
+
+		status := hm.Check(context.Background())
+		require.False(t, status.Ready)
+		require.False(t, status.NetworkConfigLoaded)
+		require.Equal(t, errEmptyChainID.Error(), status.Error)
+	})
+	t.Run("wallet not funded", func(t *testing.T) {
+		proxy := createHealthyProxy()
+		proxy.GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Balance: "99"}, nil
+		}
+		hm, _ := NewHealthMonitor(createArgs(proxy))
+
+		status := hm.Check(context.Background())
+		require.False(t, status.Ready)
+		require.True(t, status.ProxyReachable)
+		require.False(t, status.WalletFunded)
+		require.Contains(t, status.Error, chain.ErrInsufficientBalance.Error())
+		requireServingStatus(t, hm, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	})
+	t.Run("paused server should not serve", func(t *testing.T) {
+		hm, _ := NewHealthMonitor(createArgs(createHealthyProxy()))
+		hm.Check(context.Background())
+
+		hm.SetPaused(true)
+		require.True(t, hm.Status().Paused)
+		require.False(t, hm.Status().Ready)
+		requireServingStatus(t, hm, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+		status := hm.Check(context.Background())
+		require.True(t, status.Paused)
+		require.False(t, status.Ready)
+
+		hm.SetPaused(false)
+		require.True(t, hm.Status().Ready)
+		requireServingStatus(t, hm, grpc_health_v1.HealthCheckResponse_SERVING)
+	})
+}
+
+func TestHealthMonitor_StartChecks(t *testing.T) {
+	t.Parallel()
+
+	proxy := createHealthyProxy()
+	proxy.GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+		return &data.Account{Balance: "0"}, nil
+	}
+	hm, _ := NewHealthMonitor(createArgs(proxy))
+
+	hm.StartChecks()
+	require.Eventually(t, func() bool {
+		return !hm.Status().LastCheck.IsZero()
+	}, time.Second, time.Millisecond)
+	require.False(t, hm.Status().Ready)
+
+	require.Nil(t, hm.Close())
+	require.Nil(t, hm.Close())
+
+	res, err := hm.HealthServer().Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.Nil(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.Status)
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/requestID"
+)
+
+const unknownClient = "unknown"
+
+// NewUnaryInterceptors creates the server interceptor chain, to be used with grpc.ChainUnaryInterceptor. In order,
+// the interceptors: attach a request id to the context, record the method latency, count the received bridge
+// operations and failed requests, write the access log and recover from handler panics, returning an internal error.
+func NewUnaryInterceptors(latencyRecorder LatencyRecorder, bridgeMetrics BridgeMetrics) ([]grpc.UnaryServerInterceptor, error) {
+	if check.IfNil(latencyRecorder) {
+		return nil, errNilLatencyRecorder
+	}
+	if check.IfNil(bridgeMetrics) {
+		return nil, errNilBridgeMetrics
+	}
+
+	return []grpc.UnaryServerInterceptor{
+		requestIDInterceptor,
+		newLatencyInterceptor(latencyRecorder),
+		newBridgeMetricsInterceptor(bridgeMetrics),
+		accessLogInterceptor,
+		recoveryInterceptor,
+	}, nil
+}
+
+// requestIDInterceptor uses the request id sent by the client, or generates a new one, and returns it in the
+// response header
+func requestIDInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := requestID.FromIncomingContext(ctx)
+	if len(id) == 0 {
+		id = requestID.New()
+	}
+
+	err := grpc.SetHeader(ctx, metadata.Pairs(requestID.MetadataKey, id))
+	if err != nil {
+		log.Debug("could not set request id header", "request id", id, "error", err)
+	}
+
+	return handler(requestID.NewContext(ctx, id), req)
+}
+
+func newLatencyInterceptor(latencyRecorder LatencyRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		latencyRecorder.RecordLatency(info.FullMethod, status.Code(err), time.Since(start))
+
+		return res, err
+	}
+}
+
+// newBridgeMetricsInterceptor counts the bridge data and operations of each received request. Failed requests are
+// counted by their error info reason, or by their status code if the error holds no reason.
+func newBridgeMetricsInterceptor(bridgeMetrics BridgeMetrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		_, isBridgeOps := req.(*sovereign.BridgeOperations)
+		if !isBridgeOps {
+			return handler(ctx, req)
+		}
+
+		bridgeMetrics.AddReceivedBridgeOperations(countOperations(req))
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			bridgeMetrics.AddSendFailure(failureReason(err))
+		}
+
+		return res, err
+	}
+}
+
+func failureReason(err error) string {
+	reason := bridgeErrors.Reason(err)
+	if len(reason) != 0 {
+		return reason
+	}
+
+	return status.Code(err).String()
+}
+
+func accessLogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+
+	numBridgeData, numOperations := countOperations(req)
+	log.Info("handled request",
+		"method", info.FullMethod,
+		"request id", requestID.FromContext(ctx),
+		"client", clientIdentity(ctx),
+		"bridge data", numBridgeData,
+		"operations", numOperations,
+		"duration", time.Since(start),
+		"code", status.Code(err))
+
+	return res, err
+}
+
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		log.Error("recovered from panic while handling request",
+			"method", info.FullMethod,
+			"request id", requestID.FromContext(ctx),
+			"panic", r,
+			"stack", string(debug.Stack()))
+
+		res = nil
+		err = status.Error(codes.Internal, "internal error")
+	}()
+
+	return handler(ctx, req)
+}
+
+// clientIdentity returns the common name of the client certificate, or the client address if the connection was not
+// authenticated with a certificate
+func clientIdentity(ctx context.Context) string {
+	p, found := peer.FromContext(ctx)
+	if !found {
+		return unknownClient
+	}
+
+	tlsInfo, isTLS := p.AuthInfo.(credentials.TLSInfo)
+	if isTLS && len(tlsInfo.State.PeerCertificates) > 0 {
+		return tlsInfo.State.PeerCertificates[0].Subject.CommonName
+	}
+	if p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return unknownClient
+}
+
+func countOperations(req interface{}) (int, int) {
+	bridgeOps, isBridgeOps := req.(*sovereign.BridgeOperations)
+	if !isBridgeOps {
+		return 0, 0
+	}
+
+	numOperations := 0
+	for _, bridgeData := range bridgeOps.GetData() {
+		numOperations += len(bridgeData.GetOutGoingOperations())
+	}
+
+	return len(bridgeOps.GetData()), numOperations
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/requestID"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+const sendMethod = "/sovereign.BridgeTxSender/Send"
+
+func TestNewUnaryInterceptors(t *testing.T) {
+	t.Parallel()
+
+	interceptors, err := NewUnaryInterceptors(nil, &testscommon.BridgeMetricsMock{})
+	require.Equal(t, errNilLatencyRecorder, err)
+	require.Nil(t, interceptors)
+
+	interceptors, err = NewUnaryInterceptors(&testscommon.LatencyRecorderMock{}, nil)
+	require.Equal(t, errNilBridgeMetrics, err)
+	require.Nil(t, interceptors)
+
+	interceptors, err = NewUnaryInterceptors(&testscommon.LatencyRecorderMock{}, &testscommon.BridgeMetricsMock{})
+	require.Nil(t, err)
+	require.Len(t, interceptors, 5)
+}
+
+func TestRecoveryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	info := &grpc.UnaryServerInfo{FullMethod: sendMethod}
+	res, err := recoveryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("nil pointer")
+	})
+	require.Nil(t, res)
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	expectedErr := errors.New("send error")
+	res, err = recoveryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "response", expectedErr
+	})
+	require.Equal(t, "response", res)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestLatencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	recordedCodes := make([]codes.Code, 0)
+	var maxDuration time.Duration
+	latencyRecorder := &testscommon.LatencyRecorderMock{
+		RecordLatencyCalled: func(method string, code codes.Code, duration time.Duration) {
+			require.Equal(t, sendMethod, method)
+			recordedCodes = append(recordedCodes, code)
+			if duration > maxDuration {
+				maxDuration = duration
+			}
+		},
+	}
+	interceptor := newLatencyInterceptor(latencyRecorder)
+	info := &grpc.UnaryServerInfo{FullMethod: sendMethod}
+
+	_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		time.Sleep(time.Millisecond * 10)
+		return nil, nil
+	})
+	_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.InvalidArgument, "invalid")
+	})
+
+	require.Equal(t, []codes.Code{codes.OK, codes.InvalidArgument}, recordedCodes)
+	require.GreaterOrEqual(t, maxDuration, time.Millisecond*10)
+}
+
+func TestBridgeMetricsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	numBridgeData, numOperations := 0, 0
+	failures := make([]string, 0)
+	interceptor := newBridgeMetricsInterceptor(&testscommon.BridgeMetricsMock{
+		AddReceivedBridgeOperationsCalled: func(bridgeData int, operations int) {
+			numBridgeData += bridgeData
+			numOperations += operations
+		},
+		AddSendFailureCalled: func(reason string) {
+			failures = append(failures, reason)
+		},
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: sendMethod}
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{OutGoingOperations: make([]*sovereign.OutGoingOperation, 2)},
+		},
+	}
+
+	_, _ = interceptor(context.Background(), bridgeOps, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	_, _ = interceptor(context.Background(), bridgeOps, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, bridgeErrors.ToGRPCError(&bridgeErrors.ValidationError{Field: "data", Description: "invalid"})
+	})
+	_, _ = interceptor(context.Background(), bridgeOps, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, "internal error")
+	})
+	_, _ = interceptor(context.Background(), "health check", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, "internal error")
+	})
+
+	require.Equal(t, 3, numBridgeData)
+	require.Equal(t, 6, numOperations)
+	require.Equal(t, []string{bridgeErrors.ReasonValidation, codes.Internal.String()}, failures)
+}
+
+func TestClientIdentity(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, unknownClient, clientIdentity(context.Background()))
+
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	require.Equal(t, "127.0.0.1:1234", clientIdentity(ctx))
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{
+		Addr: addr,
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "sovereign-node"}}},
+			},
+		},
+	})
+	require.Equal(t, "sovereign-node", clientIdentity(ctx))
+}
+
+func TestCountOperations(t *testing.T) {
+	t.Parallel()
+
+	numBridgeData, numOperations := countOperations("request")
+	require.Zero(t, numBridgeData)
+	require.Zero(t, numOperations)
+
+	numBridgeData, numOperations = countOperations(&sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{OutGoingOperations: make([]*sovereign.OutGoingOperation, 2)},
+			{OutGoingOperations: make([]*sovereign.OutGoingOperation, 3)},
+		},
+	})
+	require.Equal(t, 2, numBridgeData)
+	require.Equal(t, 5, numOperations)
+}
+
+func TestUnaryInterceptorsChain(t *testing.T) {
+	t.Parallel()
+
+	var receivedRequestID string
+	txSender := &testscommon.TxSenderMock{
+		SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+			receivedRequestID = requestID.FromContext(ctx)
+			if len(data.Data) == 0 {
+				panic("unexpected empty data")
+			}
+
+			return []string{"txHash"}, nil
+		},
+	}
+	bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+
+	mutCodes := sync.Mutex{}
+	recordedCodes := make([]codes.Code, 0)
+	latencyRecorder := &testscommon.LatencyRecorderMock{
+		RecordLatencyCalled: func(method string, code codes.Code, duration time.Duration) {
+			mutCodes.Lock()
+			recordedCodes = append(recordedCodes, code)
+			mutCodes.Unlock()
+		},
+	}
+	interceptors, _ := NewUnaryInterceptors(latencyRecorder, &testscommon.BridgeMetricsMock{})
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	sovereign.RegisterBridgeTxSenderServer(grpcServer, bridgeServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}))
+	require.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	client := sovereign.NewBridgeTxSenderClient(conn)
+	bridgeOps := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+			},
+		},
+	}
+
+	t.Run("request id from client should be propagated", func(t *testing.T) {
+		var header metadata.MD
+		ctx := requestID.AppendToOutgoingContext(context.Background(), "client-request-id")
+		_, err := client.Send(ctx, bridgeOps, grpc.Header(&header))
+		require.Nil(t, err)
+		require.Equal(t, "client-request-id", receivedRequestID)
+		require.Equal(t, []string{"client-request-id"}, header.Get(requestID.MetadataKey))
+	})
+	t.Run("request id should be generated", func(t *testing.T) {
+		var header metadata.MD
+		_, err := client.Send(context.Background(), bridgeOps, grpc.Header(&header))
+		require.Nil(t, err)
+		require.Len(t, receivedRequestID, 32)
+		require.Equal(t, []string{receivedRequestID}, header.Get(requestID.MetadataKey))
+	})
+	t.Run("panic should return internal error and be recorded", func(t *testing.T) {
+		_, err := client.Send(context.Background(), &sovereign.BridgeOperations{})
+		require.Equal(t, codes.Internal, status.Code(err))
+
+		mutCodes.Lock()
+		defer mutCodes.Unlock()
+		require.Equal(t, []codes.Code{codes.OK, codes.OK, codes.Internal}, recordedCodes)
+	})
+}
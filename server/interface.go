@@ -2,8 +2,21 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"math/big"
+	"net/http"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/admin"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
 )
 
 // TxSender defines a tx sender for bridge operations
@@ -11,3 +24,114 @@ type TxSender interface {
 	SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error)
 	IsInterfaceNil() bool
 }
+
+// TxSenderHandler defines the tx sender managed by the server binary
+type TxSenderHandler interface {
+	TxSender
+	TxStatsProvider
+	Shutdown(ctx context.Context) error
+	Close() error
+}
+
+// TxStatsProvider defines a provider of the tx sender stats
+type TxStatsProvider interface {
+	Stats() txSender.Stats
+	IsInterfaceNil() bool
+}
+
+// BridgeOperationsValidator defines a validator for received bridge operations
+type BridgeOperationsValidator interface {
+	Validate(data *sovereign.BridgeOperations) error
+	IsInterfaceNil() bool
+}
+
+// ReloadableValidator defines a validator for received bridge operations whose limits can be changed at runtime
+type ReloadableValidator interface {
+	BridgeOperationsValidator
+	SetConfig(cfg config.ValidatorConfig)
+}
+
+// LatencyRecorder defines a recorder for grpc method call durations
+type LatencyRecorder interface {
+	RecordLatency(method string, code codes.Code, duration time.Duration)
+	IsInterfaceNil() bool
+}
+
+// BridgeMetrics defines a recorder for received bridge operations and failed requests
+type BridgeMetrics interface {
+	AddReceivedBridgeOperations(numBridgeData int, numOperations int)
+	AddSendFailure(reason string)
+	IsInterfaceNil() bool
+}
+
+// MetricsHandler defines the bridge server metrics, updated by all server components and served over http
+type MetricsHandler interface {
+	LatencyRecorder
+	BridgeMetrics
+	AddSentTxs(endpoint string, numTxs int)
+	RecordProxyLatency(call string, duration time.Duration)
+	SetWalletBalance(balance *big.Int)
+	SetNonce(nonce uint64)
+	SetQueueDepth(depth int)
+	SetCertificateExpiry(expiry time.Time)
+	Handler() http.Handler
+}
+
+// HealthMonitor defines the bridge server readiness monitor, which reports its status through the grpc health service
+type HealthMonitor interface {
+	StartChecks()
+	SetPaused(paused bool)
+	Status() health.Status
+	HealthServer() grpc_health_v1.HealthServer
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// AdminController defines the controller which pauses, resumes and drains the bridge sender
+type AdminController interface {
+	Pause(mode admin.PauseMode) (admin.Status, error)
+	Resume() (admin.Status, error)
+	Drain(ctx context.Context) (admin.Status, error)
+	Status() admin.Status
+	IsInterfaceNil() bool
+}
+
+// SendingConfigProvider defines the provider of the bridge contracts and gas settings used by the tx sender
+type SendingConfigProvider interface {
+	SendingConfig() txSender.SendingConfig
+	IsInterfaceNil() bool
+}
+
+// SendingConfigUpdater defines the component which updates the bridge contracts and gas settings at runtime
+type SendingConfigUpdater interface {
+	SendingConfigProvider
+	UpdateSendingConfig(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error)
+}
+
+// LogStreamer defines the logs websocket handler, whose access config can be changed at runtime
+type LogStreamer interface {
+	Handle(c *gin.Context)
+	SetConfig(cfg config.LogWebSocketConfig)
+	IsInterfaceNil() bool
+}
+
+// TLSReloader defines the component which reloads the server certificate without restarting the server
+type TLSReloader interface {
+	Reload(cfg cert.FileCfg) error
+	SetTLSConfig(tlsConfig *tls.Config)
+	CertificateExpiry() (time.Time, error)
+	IsInterfaceNil() bool
+}
+
+// ConfigReloader defines the component which applies the reloadable fields of a new config to the running server
+type ConfigReloader interface {
+	Reload(ctx context.Context, cfg *config.ServerConfig, maskedFields []string) (ReloadResult, error)
+	IsInterfaceNil() bool
+}
+
+// StatusProvider defines the provider of the server readiness and status summary
+type StatusProvider interface {
+	Readiness() health.Status
+	Status() BridgeStatus
+	IsInterfaceNil() bool
+}
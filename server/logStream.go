@@ -0,0 +1,271 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-chain/api/logs"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+)
+
+const (
+	logStreamPath             = "/log"
+	logLevelQueryParam        = "level"
+	defaultMaxLogSubscribers  = 5
+	matchAllLoggersPattern    = "*"
+	defaultSubscriberLogLevel = logger.LogTrace
+)
+
+// logStreamAccess holds the logs websocket access config, replaced as a whole when the config is reloaded
+type logStreamAccess struct {
+	authToken      []byte
+	maxSubscribers int64
+	checkOrigin    func(r *http.Request) bool
+}
+
+type logStreamer struct {
+	marshaller  marshal.Marshalizer
+	access      atomic.Pointer[logStreamAccess]
+	subscribers atomic.Int64
+}
+
+// NewLogStreamer creates the logs websocket handler. Subscribers should authenticate with a client certificate or with
+// the configured token and can set their own log level patterns with the level query parameter, in the same format as
+// the log level flag (e.g.: *:INFO,txSender:DEBUG).
+func NewLogStreamer(marshaller marshal.Marshalizer, cfg config.LogWebSocketConfig) (*logStreamer, error) {
+	if check.IfNil(marshaller) {
+		return nil, errNilMarshaller
+	}
+
+	ls := &logStreamer{
+		marshaller: marshaller,
+	}
+	ls.SetConfig(cfg)
+
+	return ls, nil
+}
+
+// SetConfig replaces the access config, used starting with the next subscribers. Connected subscribers are kept, even
+// if above the new max subscribers.
+func (ls *logStreamer) SetConfig(cfg config.LogWebSocketConfig) {
+	maxSubscribers := cfg.MaxSubscribers
+	if maxSubscribers <= 0 {
+		maxSubscribers = defaultMaxLogSubscribers
+	}
+
+	ls.access.Store(&logStreamAccess{
+		authToken:      []byte(cfg.AuthToken),
+		maxSubscribers: int64(maxSubscribers),
+		checkOrigin:    createOriginChecker(cfg.AllowedOrigins),
+	})
+}
+
+// registerLoggerWsRoute registers the logs websocket
+func registerLoggerWsRoute(router *gin.Engine, streamer LogStreamer) {
+	router.GET(logStreamPath, streamer.Handle)
+}
+
+// createOriginChecker allows requests without origin, which are not sent by browsers, and requests from the allowed
+// origins. If no origins are allowed, the websocket default same host check is used.
+func createOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		return nil
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 {
+			return true
+		}
+
+		for _, allowedOrigin := range allowedOrigins {
+			if strings.EqualFold(origin, allowedOrigin) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Handle authenticates the subscriber and streams the log lines until the connection is closed
+func (ls *logStreamer) Handle(c *gin.Context) {
+	access := ls.access.Load()
+	if !access.isAuthenticated(c.Request) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, &ErrorResponseJSON{
+			Code:    codes.Unauthenticated.String(),
+			Message: "client certificate or auth token required",
+		})
+		return
+	}
+
+	filter, err := newLogLevelFilter(c.Query(logLevelQueryParam))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &ErrorResponseJSON{
+			Code:    codes.InvalidArgument.String(),
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if ls.subscribers.Add(1) > access.maxSubscribers {
+		ls.subscribers.Add(-1)
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, &ErrorResponseJSON{
+			Code:    codes.ResourceExhausted.String(),
+			Message: "too many log subscribers",
+		})
+		return
+	}
+	defer ls.subscribers.Add(-1)
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: access.checkOrigin,
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("could not upgrade log websocket connection", "error", err)
+		return
+	}
+
+	ls.stream(conn, filter)
+}
+
+// isAuthenticated checks that the subscriber presented a verified client certificate or the configured bearer token
+func (access *logStreamAccess) isAuthenticated(req *http.Request) bool {
+	if isClientAuthenticated(req) {
+		return true
+	}
+
+	return hasBearerToken(req, access.authToken)
+}
+
+// stream sends the log lines passing the subscriber filter until the connection is closed. Lines are filtered by the
+// subscriber formatter, the global logger levels are left unchanged.
+func (ls *logStreamer) stream(conn *websocket.Conn, filter *logLevelFilter) {
+	formatter, err := logger.NewLogLineWrapperFormatter(ls.marshaller)
+	if err != nil {
+		log.Error("could not create log formatter", "error", err)
+		_ = conn.Close()
+		return
+	}
+
+	writer := logs.NewLogWriter()
+	err = logger.AddLogObserver(writer, &filteringFormatter{
+		formatter: formatter,
+		filter:    filter,
+	})
+	if err != nil {
+		log.Error("could not add log observer", "error", err)
+		_ = conn.Close()
+		return
+	}
+
+	log.Info("log subscriber connected", "remote address", conn.RemoteAddr().String())
+	defer func() {
+		_ = logger.RemoveLogObserver(writer)
+		_ = writer.Close()
+		_ = conn.Close()
+		log.Info("log subscriber disconnected", "remote address", conn.RemoteAddr().String())
+	}()
+
+	go monitorLogConnection(conn, writer)
+
+	for {
+		data, ok := writer.ReadBlocking()
+		if !ok {
+			return
+		}
+
+		err = conn.WriteMessage(websocket.TextMessage, data)
+		if err != nil {
+			log.Debug("could not send log line", "error", err)
+			return
+		}
+	}
+}
+
+// monitorLogConnection closes the writer once the subscriber closes the connection. Received messages, such as the
+// log profiles sent by the log viewer, are ignored.
+func monitorLogConnection(conn *websocket.Conn, writer io.Closer) {
+	defer func() {
+		_ = writer.Close()
+	}()
+
+	for {
+		_, _, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// logLevelFilter holds the log level patterns of a subscriber. As in the logger subsystem, patterns are applied from
+// left to right, the last one matching a logger name setting its level. Since lines below the global logger levels
+// are never emitted, a subscriber can only narrow down the streamed lines.
+type logLevelFilter struct {
+	levels   []logger.LogLevel
+	patterns []string
+}
+
+func newLogLevelFilter(logLevelPatterns string) (*logLevelFilter, error) {
+	if len(logLevelPatterns) == 0 {
+		return &logLevelFilter{}, nil
+	}
+
+	levels, patterns, err := logger.ParseLogLevelAndMatchingString(logLevelPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logLevelFilter{
+		levels:   levels,
+		patterns: patterns,
+	}, nil
+}
+
+func (llf *logLevelFilter) isAllowed(loggerName string, level logger.LogLevel) bool {
+	minLevel := defaultSubscriberLogLevel
+	for i, pattern := range llf.patterns {
+		if pattern == matchAllLoggersPattern || strings.Contains(loggerName, pattern) {
+			minLevel = llf.levels[i]
+		}
+	}
+
+	return level >= minLevel
+}
+
+type filteringFormatter struct {
+	formatter logger.Formatter
+	filter    *logLevelFilter
+}
+
+// Output formats the log line if it passes the subscriber filter, otherwise it returns nil, which is not written
+func (ff *filteringFormatter) Output(line logger.LogLineHandler) []byte {
+	if check.IfNil(line) {
+		return nil
+	}
+	if !ff.filter.isAllowed(line.GetLoggerName(), logger.LogLevel(line.GetLogLevel())) {
+		return nil
+	}
+
+	return ff.formatter.Output(line)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ff *filteringFormatter) IsInterfaceNil() bool {
+	return ff == nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ls *logStreamer) IsInterfaceNil() bool {
+	return ls == nil
+}
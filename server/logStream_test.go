@@ -0,0 +1,269 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+)
+
+const testLogWsToken = "secret"
+
+func startLogStreamServer(cfg config.LogWebSocketConfig) *httptest.Server {
+	router := gin.New()
+	streamer, _ := NewLogStreamer(&marshal.GogoProtoMarshalizer{}, cfg)
+	registerLoggerWsRoute(router, streamer)
+
+	return httptest.NewServer(router)
+}
+
+func dialLogStream(server *httptest.Server, query string, header http.Header) (*websocket.Conn, *http.Response, error) {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + logStreamPath + query
+	return websocket.DefaultDialer.Dial(url, header)
+}
+
+func createTokenHeader(token string) http.Header {
+	return http.Header{authorizationHeader: []string{bearerPrefix + token}}
+}
+
+func TestNewLogStreamer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		streamer, err := NewLogStreamer(nil, config.LogWebSocketConfig{})
+		require.Equal(t, errNilMarshaller, err)
+		require.Nil(t, streamer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		streamer, err := NewLogStreamer(&marshal.GogoProtoMarshalizer{}, config.LogWebSocketConfig{})
+		require.Nil(t, err)
+		require.False(t, streamer.IsInterfaceNil())
+		require.Equal(t, int64(defaultMaxLogSubscribers), streamer.access.Load().maxSubscribers)
+	})
+}
+
+func TestLogStreamAccess_IsAuthenticated(t *testing.T) {
+	t.Parallel()
+
+	streamer := &logStreamAccess{authToken: []byte(testLogWsToken)}
+
+	req := httptest.NewRequest(http.MethodGet, logStreamPath, nil)
+	require.False(t, streamer.isAuthenticated(req))
+
+	req.Header.Set(authorizationHeader, bearerPrefix+"wrong")
+	require.False(t, streamer.isAuthenticated(req))
+
+	req.Header.Set(authorizationHeader, testLogWsToken)
+	require.False(t, streamer.isAuthenticated(req))
+
+	req.Header.Set(authorizationHeader, bearerPrefix+testLogWsToken)
+	require.True(t, streamer.isAuthenticated(req))
+
+	req = httptest.NewRequest(http.MethodGet, logStreamPath, nil)
+	req.TLS = createVerifiedTLSState()
+	require.True(t, streamer.isAuthenticated(req))
+
+	streamer = &logStreamAccess{}
+	req = httptest.NewRequest(http.MethodGet, logStreamPath, nil)
+	req.Header.Set(authorizationHeader, bearerPrefix)
+	require.False(t, streamer.isAuthenticated(req))
+}
+
+func TestCreateOriginChecker(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, createOriginChecker(nil))
+
+	checkOrigin := createOriginChecker([]string{"https://explorer.dharitri.org"})
+
+	req := httptest.NewRequest(http.MethodGet, logStreamPath, nil)
+	require.True(t, checkOrigin(req))
+
+	req.Header.Set("Origin", "https://Explorer.dharitri.org")
+	require.True(t, checkOrigin(req))
+
+	req.Header.Set("Origin", "https://evil.org")
+	require.False(t, checkOrigin(req))
+}
+
+func TestLogLevelFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid patterns", func(t *testing.T) {
+		filter, err := newLogLevelFilter("*:LOUD")
+		require.NotNil(t, err)
+		require.Nil(t, filter)
+
+		filter, err = newLogLevelFilter("INFO")
+		require.Equal(t, logger.ErrInvalidLogLevelPattern, err)
+		require.Nil(t, filter)
+	})
+	t.Run("no patterns should allow all lines", func(t *testing.T) {
+		filter, err := newLogLevelFilter("")
+		require.Nil(t, err)
+		require.True(t, filter.isAllowed("server", logger.LogTrace))
+	})
+	t.Run("last matching pattern should set the level", func(t *testing.T) {
+		filter, err := newLogLevelFilter("*:WARN,txSender:DEBUG,txSender/nonce:ERROR")
+		require.Nil(t, err)
+
+		require.False(t, filter.isAllowed("server", logger.LogInfo))
+		require.True(t, filter.isAllowed("server", logger.LogWarning))
+		require.True(t, filter.isAllowed("server/txSender", logger.LogDebug))
+		require.False(t, filter.isAllowed("server/txSender", logger.LogTrace))
+		require.False(t, filter.isAllowed("server/txSender/nonce", logger.LogWarning))
+		require.True(t, filter.isAllowed("server/txSender/nonce", logger.LogError))
+	})
+}
+
+func TestLogStreamer_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	router := gin.New()
+	streamer, _ := NewLogStreamer(&marshal.GogoProtoMarshalizer{}, config.LogWebSocketConfig{AuthToken: testLogWsToken})
+	registerLoggerWsRoute(router, streamer)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	conn, _, err := dialLogStream(server, "", createTokenHeader(testLogWsToken))
+	require.Nil(t, err)
+
+	streamer.SetConfig(config.LogWebSocketConfig{AuthToken: "new secret"})
+
+	// connected subscribers should be kept, new ones should use the new token
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte("ping")))
+	_, resp, err := dialLogStream(server, "", createTokenHeader(testLogWsToken))
+	require.Equal(t, websocket.ErrBadHandshake, err)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	secondConn, _, err := dialLogStream(server, "", createTokenHeader("new secret"))
+	require.Nil(t, err)
+	require.Nil(t, secondConn.Close())
+	require.Nil(t, conn.Close())
+}
+
+func TestLogStreamer_Handle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthenticated subscriber should be rejected", func(t *testing.T) {
+		server := startLogStreamServer(config.LogWebSocketConfig{AuthToken: testLogWsToken})
+		defer server.Close()
+
+		conn, resp, err := dialLogStream(server, "", nil)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Nil(t, conn)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		conn, resp, err = dialLogStream(server, "", createTokenHeader("wrong"))
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Nil(t, conn)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+	t.Run("invalid log level patterns should be rejected", func(t *testing.T) {
+		server := startLogStreamServer(config.LogWebSocketConfig{AuthToken: testLogWsToken})
+		defer server.Close()
+
+		conn, resp, err := dialLogStream(server, "?level=*:LOUD", createTokenHeader(testLogWsToken))
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Nil(t, conn)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+	t.Run("origin not allowed should be rejected", func(t *testing.T) {
+		server := startLogStreamServer(config.LogWebSocketConfig{
+			AuthToken:      testLogWsToken,
+			AllowedOrigins: []string{"https://explorer.dharitri.org"},
+		})
+		defer server.Close()
+
+		header := createTokenHeader(testLogWsToken)
+		header.Set("Origin", "https://evil.org")
+		conn, resp, err := dialLogStream(server, "", header)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Nil(t, conn)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		header.Set("Origin", "https://explorer.dharitri.org")
+		conn, _, err = dialLogStream(server, "", header)
+		require.Nil(t, err)
+		require.Nil(t, conn.Close())
+	})
+	t.Run("subscribers above limit should be rejected", func(t *testing.T) {
+		server := startLogStreamServer(config.LogWebSocketConfig{
+			AuthToken:      testLogWsToken,
+			MaxSubscribers: 1,
+		})
+		defer server.Close()
+
+		conn, _, err := dialLogStream(server, "", createTokenHeader(testLogWsToken))
+		require.Nil(t, err)
+
+		secondConn, resp, err := dialLogStream(server, "", createTokenHeader(testLogWsToken))
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Nil(t, secondConn)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+		require.Nil(t, conn.Close())
+		require.Eventually(t, func() bool {
+			secondConn, _, err = dialLogStream(server, "", createTokenHeader(testLogWsToken))
+			if err != nil {
+				return false
+			}
+
+			return secondConn.Close() == nil
+		}, time.Second*5, time.Millisecond*10)
+	})
+	t.Run("should stream log lines filtered by subscriber log level", func(t *testing.T) {
+		server := startLogStreamServer(config.LogWebSocketConfig{AuthToken: testLogWsToken})
+		defer server.Close()
+
+		conn, _, err := dialLogStream(server, "?level=*:WARN", createTokenHeader(testLogWsToken))
+		require.Nil(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		testLog := logger.GetOrCreate("server/logStreamTest")
+		infoMessage := "log stream test info line"
+		warnMessage := "log stream test warn line"
+		marshaller := &marshal.GogoProtoMarshalizer{}
+
+		// the subscriber observer is registered after the upgrade, so lines are emitted until one is received
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				testLog.Info(infoMessage)
+				testLog.Warn(warnMessage)
+
+				select {
+				case <-done:
+					return
+				case <-time.After(time.Millisecond * 10):
+				}
+			}
+		}()
+
+		require.Nil(t, conn.SetReadDeadline(time.Now().Add(time.Second*5)))
+		for {
+			_, data, errRead := conn.ReadMessage()
+			require.Nil(t, errRead)
+
+			line := &logger.LogLineWrapper{}
+			require.Nil(t, marshaller.Unmarshal(line, data))
+			require.GreaterOrEqual(t, line.LogLevel, int32(logger.LogWarning))
+			require.NotEqual(t, infoMessage, line.Message)
+			if line.Message == warnMessage {
+				return
+			}
+		}
+	})
+}
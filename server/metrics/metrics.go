@@ -0,0 +1,165 @@
+package metrics
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "sovereign_bridge"
+
+type prometheusMetrics struct {
+	registry *prometheus.Registry
+
+	receivedBridgeData *prometheus.CounterVec
+	receivedOperations *prometheus.CounterVec
+	sentTxs            *prometheus.CounterVec
+	sendFailures       *prometheus.CounterVec
+
+	requestLatency *prometheus.HistogramVec
+	proxyLatency   *prometheus.HistogramVec
+
+	walletBalance     prometheus.Gauge
+	nonce             prometheus.Gauge
+	queueDepth        prometheus.Gauge
+	certificateExpiry prometheus.Gauge
+}
+
+// NewPrometheusMetrics creates the bridge server metrics, registered on their own prometheus registry together with
+// the go runtime and process collectors
+func NewPrometheusMetrics() *prometheusMetrics {
+	pm := &prometheusMetrics{
+		registry: prometheus.NewRegistry(),
+		receivedBridgeData: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "received_bridge_data_total",
+			Help:      "Number of received bridge data",
+		}, nil),
+		receivedOperations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "received_operations_total",
+			Help:      "Number of received outgoing operations",
+		}, nil),
+		sentTxs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "sent_txs_total",
+			Help:      "Number of txs sent to main chain, per sc endpoint",
+		}, []string{"endpoint"}),
+		sendFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "send_failures_total",
+			Help:      "Number of failed bridge operations requests, per failure reason",
+		}, []string{"reason"}),
+		requestLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of grpc requests, such as Send, per method and status code",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		proxyLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "proxy_call_duration_seconds",
+			Help:      "Duration of proxy calls, per call",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"call"}),
+		walletBalance: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "wallet_balance",
+			Help:      "Balance of the wallet sending the txs, in denominated units",
+		}),
+		nonce: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "wallet_nonce",
+			Help:      "Last nonce assigned to a tx sent by the wallet",
+		}),
+		queueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_depth",
+			Help:      "Number of bridge operations requests waiting to be assigned nonces",
+		}),
+		certificateExpiry: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "certificate_expiry_timestamp_seconds",
+			Help:      "Expiry of the server certificate, as unix timestamp",
+		}),
+	}
+
+	pm.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		pm.receivedBridgeData,
+		pm.receivedOperations,
+		pm.sentTxs,
+		pm.sendFailures,
+		pm.requestLatency,
+		pm.proxyLatency,
+		pm.walletBalance,
+		pm.nonce,
+		pm.queueDepth,
+		pm.certificateExpiry,
+	)
+
+	return pm
+}
+
+// AddReceivedBridgeOperations counts the received bridge data and operations
+func (pm *prometheusMetrics) AddReceivedBridgeOperations(numBridgeData int, numOperations int) {
+	pm.receivedBridgeData.WithLabelValues().Add(float64(numBridgeData))
+	pm.receivedOperations.WithLabelValues().Add(float64(numOperations))
+}
+
+// AddSendFailure counts a failed bridge operations request
+func (pm *prometheusMetrics) AddSendFailure(reason string) {
+	pm.sendFailures.WithLabelValues(reason).Inc()
+}
+
+// AddSentTxs counts the txs sent to the sc endpoint
+func (pm *prometheusMetrics) AddSentTxs(endpoint string, numTxs int) {
+	pm.sentTxs.WithLabelValues(endpoint).Add(float64(numTxs))
+}
+
+// RecordLatency records the duration of a grpc method call
+func (pm *prometheusMetrics) RecordLatency(method string, code codes.Code, duration time.Duration) {
+	pm.requestLatency.WithLabelValues(method, code.String()).Observe(duration.Seconds())
+}
+
+// RecordProxyLatency records the duration of a proxy call
+func (pm *prometheusMetrics) RecordProxyLatency(call string, duration time.Duration) {
+	pm.proxyLatency.WithLabelValues(call).Observe(duration.Seconds())
+}
+
+// SetWalletBalance sets the wallet balance
+func (pm *prometheusMetrics) SetWalletBalance(balance *big.Int) {
+	value, _ := new(big.Float).SetInt(balance).Float64()
+	pm.walletBalance.Set(value)
+}
+
+// SetNonce sets the last nonce assigned to a tx
+func (pm *prometheusMetrics) SetNonce(nonce uint64) {
+	pm.nonce.Set(float64(nonce))
+}
+
+// SetQueueDepth sets the number of requests waiting to be assigned nonces
+func (pm *prometheusMetrics) SetQueueDepth(depth int) {
+	pm.queueDepth.Set(float64(depth))
+}
+
+// SetCertificateExpiry sets the server certificate expiry
+func (pm *prometheusMetrics) SetCertificateExpiry(expiry time.Time) {
+	pm.certificateExpiry.Set(float64(expiry.Unix()))
+}
+
+// Handler returns the http handler which serves the metrics in prometheus text format
+func (pm *prometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(pm.registry, promhttp.HandlerOpts{})
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (pm *prometheusMetrics) IsInterfaceNil() bool {
+	return pm == nil
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func scrape(t *testing.T, pm *prometheusMetrics) string {
+	w := httptest.NewRecorder()
+	pm.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	return w.Body.String()
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	pm := NewPrometheusMetrics()
+	require.False(t, pm.IsInterfaceNil())
+
+	pm.AddReceivedBridgeOperations(2, 5)
+	pm.AddSentTxs("register", 2)
+	pm.AddSentTxs("execute", 5)
+	pm.AddSendFailure("NONCE")
+	pm.RecordLatency("/sovereign.BridgeTxSender/Send", codes.OK, time.Millisecond*20)
+	pm.RecordProxyLatency("/transaction/send", time.Millisecond*5)
+	pm.SetWalletBalance(big.NewInt(1000))
+	pm.SetNonce(42)
+	pm.SetQueueDepth(3)
+	pm.SetCertificateExpiry(time.Unix(1700000000, 0))
+
+	body := scrape(t, pm)
+	require.Contains(t, body, "sovereign_bridge_received_bridge_data_total 2")
+	require.Contains(t, body, "sovereign_bridge_received_operations_total 5")
+	require.Contains(t, body, `sovereign_bridge_sent_txs_total{endpoint="register"} 2`)
+	require.Contains(t, body, `sovereign_bridge_sent_txs_total{endpoint="execute"} 5`)
+	require.Contains(t, body, `sovereign_bridge_send_failures_total{reason="NONCE"} 1`)
+	require.Contains(t, body, `sovereign_bridge_grpc_request_duration_seconds_count{code="OK",method="/sovereign.BridgeTxSender/Send"} 1`)
+	require.Contains(t, body, `sovereign_bridge_proxy_call_duration_seconds_count{call="/transaction/send"} 1`)
+	require.Contains(t, body, "sovereign_bridge_wallet_balance 1000")
+	require.Contains(t, body, "sovereign_bridge_wallet_nonce 42")
+	require.Contains(t, body, "sovereign_bridge_queue_depth 3")
+	require.Contains(t, body, "sovereign_bridge_certificate_expiry_timestamp_seconds 1.7e+09")
+	require.Contains(t, body, "go_goroutines")
+}
+
+func TestNewPrometheusMetrics_ShouldUseSeparateRegistries(t *testing.T) {
+	t.Parallel()
+
+	pm1 := NewPrometheusMetrics()
+	pm2 := NewPrometheusMetrics()
+	pm1.SetNonce(1)
+	pm2.SetNonce(2)
+
+	require.Contains(t, scrape(t, pm1), "sovereign_bridge_wallet_nonce 1")
+	require.Contains(t, scrape(t, pm2), "sovereign_bridge_wallet_nonce 2")
+}
@@ -0,0 +1,227 @@
+package preflight
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+var log = logger.GetOrCreate("preflight")
+
+const (
+	// maxNoncesDelta is the max number of blocks the proxy can be behind the network to be considered synced
+	maxNoncesDelta = 10
+	day            = time.Hour * 24
+)
+
+// ArgsPreflightChecker holds the arguments needed to create a preflight checker
+type ArgsPreflightChecker struct {
+	Proxy                   Proxy
+	WalletConfig            txSender.WalletConfig
+	CertificateConfig       cert.FileCfg
+	HeaderVerifierSCAddress string
+	DcdtSafeSCAddress       string
+	Hasher                  string
+	ExpectedChainID         string
+	MinWalletBalance        string
+	CheckTimeout            time.Duration
+}
+
+type preflightChecker struct {
+	proxy                   Proxy
+	walletConfig            txSender.WalletConfig
+	certificateConfig       cert.FileCfg
+	headerVerifierSCAddress string
+	dcdtSafeSCAddress       string
+	hasher                  string
+	expectedChainID         string
+	minWalletBalance        string
+	checkTimeout            time.Duration
+}
+
+// NewPreflightChecker creates the checker which verifies, before the bridge server starts, that its config is valid and
+// that the proxy, the bridge contracts and the wallet can be used to send bridge txs
+func NewPreflightChecker(args ArgsPreflightChecker) (*preflightChecker, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, errNilProxy
+	}
+	if args.CheckTimeout <= 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidCheckTimeout, args.CheckTimeout)
+	}
+
+	return &preflightChecker{
+		proxy:                   args.Proxy,
+		walletConfig:            args.WalletConfig,
+		certificateConfig:       args.CertificateConfig,
+		headerVerifierSCAddress: args.HeaderVerifierSCAddress,
+		dcdtSafeSCAddress:       args.DcdtSafeSCAddress,
+		hasher:                  args.Hasher,
+		expectedChainID:         args.ExpectedChainID,
+		minWalletBalance:        args.MinWalletBalance,
+		checkTimeout:            args.CheckTimeout,
+	}, nil
+}
+
+// Run runs all checks, even if some of them fail, each one with the check timeout
+func (pc *preflightChecker) Run(ctx context.Context) Report {
+	checks := []struct {
+		name  string
+		check func(ctx context.Context) (string, error)
+	}{
+		{name: "hasher", check: pc.checkHasher},
+		{name: "certificate", check: pc.checkCertificate},
+		{name: "proxy", check: pc.checkProxy},
+		{name: "chain id", check: pc.checkChainID},
+		{name: "header verifier contract", check: func(ctx context.Context) (string, error) {
+			return pc.checkContract(ctx, pc.headerVerifierSCAddress)
+		}},
+		{name: "dcdt safe contract", check: func(ctx context.Context) (string, error) {
+			return pc.checkContract(ctx, pc.dcdtSafeSCAddress)
+		}},
+		{name: "wallet", check: pc.checkWallet},
+	}
+
+	report := Report{}
+	for _, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, pc.checkTimeout)
+		details, err := c.check(checkCtx)
+		cancel()
+
+		result := Result{
+			Name:    c.name,
+			Passed:  err == nil,
+			Details: details,
+		}
+		if err != nil {
+			result.Details = err.Error()
+			log.Debug("preflight check failed", "check", c.name, "error", err)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+func (pc *preflightChecker) checkHasher(_ context.Context) (string, error) {
+	_, err := factory.NewHasher(pc.hasher)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, pc.hasher)
+	}
+
+	return pc.hasher, nil
+}
+
+func (pc *preflightChecker) checkCertificate(_ context.Context) (string, error) {
+	tlsConfig, err := cert.LoadTLSServerConfig(pc.certificateConfig)
+	if err != nil {
+		return "", fmt.Errorf("could not load certificate %s: %w", pc.certificateConfig.CertFile, err)
+	}
+
+	certificate, err := x509.ParseCertificate(tlsConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		return "", fmt.Errorf("could not parse certificate %s: %w", pc.certificateConfig.CertFile, err)
+	}
+
+	now := time.Now()
+	if now.Before(certificate.NotBefore) {
+		return "", fmt.Errorf("%w: valid from %s", errCertificateNotValid, certificate.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if !now.Before(certificate.NotAfter) {
+		return "", fmt.Errorf("%w: expired at %s", errCertificateNotValid, certificate.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	daysLeft := int(certificate.NotAfter.Sub(now) / day)
+	return fmt.Sprintf("valid until %s, %d days left", certificate.NotAfter.UTC().Format(time.RFC3339), daysLeft), nil
+}
+
+// checkProxy verifies that the proxy is reachable and that the shard of the wallet is synced
+func (pc *preflightChecker) checkProxy(ctx context.Context) (string, error) {
+	_, err := pc.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("proxy is not reachable: %w", err)
+	}
+
+	shardID := uint32(0)
+	wallet, err := txSender.LoadWallet(pc.walletConfig)
+	if err == nil {
+		shardID, err = pc.proxy.GetShardOfAddress(ctx, wallet.GetBech32())
+		if err != nil {
+			return "", fmt.Errorf("could not get wallet shard: %w", err)
+		}
+	}
+
+	networkStatus, err := pc.proxy.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		return "", fmt.Errorf("could not get network status of shard %d: %w", shardID, err)
+	}
+	if networkStatus.ProbableHighestNonce > networkStatus.Nonce+maxNoncesDelta {
+		return "", fmt.Errorf("%w: shard %d nonce %d, probable highest nonce %d", errProxyNotSynced,
+			shardID, networkStatus.Nonce, networkStatus.ProbableHighestNonce)
+	}
+
+	return fmt.Sprintf("reachable, shard %d synced at nonce %d", shardID, networkStatus.Nonce), nil
+}
+
+func (pc *preflightChecker) checkChainID(ctx context.Context) (string, error) {
+	if len(pc.expectedChainID) == 0 {
+		return "", errNoExpectedChainID
+	}
+
+	networkConfig, err := pc.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get network config: %w", err)
+	}
+	if networkConfig.ChainID != pc.expectedChainID {
+		return "", fmt.Errorf("%w: proxy chain id %s, expected %s", errChainIDMismatch, networkConfig.ChainID, pc.expectedChainID)
+	}
+
+	return networkConfig.ChainID, nil
+}
+
+func (pc *preflightChecker) checkContract(ctx context.Context, bech32Address string) (string, error) {
+	err := chain.CheckContract(ctx, pc.proxy, bech32Address)
+	if err != nil {
+		return "", fmt.Errorf("%w, address %s", err, bech32Address)
+	}
+
+	return bech32Address, nil
+}
+
+func (pc *preflightChecker) checkWallet(ctx context.Context) (string, error) {
+	minBalance, err := chain.ParseMinBalance(pc.minWalletBalance)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errInvalidMinWalletBalance, err)
+	}
+
+	wallet, err := txSender.LoadWallet(pc.walletConfig)
+	if err != nil {
+		return "", fmt.Errorf("could not load wallet %s: %w", pc.walletConfig.Path, err)
+	}
+
+	account, err := pc.proxy.GetAccount(ctx, wallet.GetAddressHandler())
+	if err != nil {
+		return "", fmt.Errorf("could not get wallet account %s: %w", wallet.GetBech32(), err)
+	}
+
+	balance, err := chain.CheckBalance(account.Balance, minBalance)
+	if err != nil {
+		return "", fmt.Errorf("%w, wallet %s", err, wallet.GetBech32())
+	}
+
+	return fmt.Sprintf("%s, balance %s", wallet.GetBech32(), balance.String()), nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (pc *preflightChecker) IsInterfaceNil() bool {
+	return pc == nil
+}
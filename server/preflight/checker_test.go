@@ -0,0 +1,236 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+const (
+	alicePemPath         = "../txSender/testData/alice.pem"
+	aliceAddress         = "drt1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssey5egf"
+	bobAddress           = "drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"
+	testHeaderVerifierSC = "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7"
+	testDcdtSafeSC       = "drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpq2j2ext"
+	testChainID          = "T"
+)
+
+func createCertificateFiles(t *testing.T, availabilityInDays int64) cert.FileCfg {
+	dir := t.TempDir()
+	fileCfg := cert.FileCfg{
+		CertFile: filepath.Join(dir, "certificate.crt"),
+		PkFile:   filepath.Join(dir, "private_key.pem"),
+	}
+
+	err := cert.GenerateCertFiles(cert.CertificateCfg{
+		CertCfg: cert.CertCfg{
+			Organization: "test",
+			DNSName:      "localhost",
+			IPAddress:    "127.0.0.1",
+			Availability: availabilityInDays,
+		},
+		CertFileCfg: fileCfg,
+	})
+	require.Nil(t, err)
+
+	return fileCfg
+}
+
+func createProxyMock() *testscommon.ProxyMock {
+	return &testscommon.ProxyMock{
+		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{ChainID: testChainID}, nil
+		},
+		GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return &data.NetworkStatus{Nonce: 100, ProbableHighestNonce: 101, ShardID: shardID}, nil
+		},
+		GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+			return 1, nil
+		},
+		GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			bech32Address, _ := address.AddressAsBech32String()
+			if bech32Address == aliceAddress {
+				return &data.Account{Balance: "1000"}, nil
+			}
+
+			return &data.Account{CodeHash: []byte("codeHash")}, nil
+		},
+	}
+}
+
+func createArgs(t *testing.T) ArgsPreflightChecker {
+	return ArgsPreflightChecker{
+		Proxy:                   createProxyMock(),
+		WalletConfig:            txSender.WalletConfig{Path: alicePemPath},
+		CertificateConfig:       createCertificateFiles(t, 30),
+		HeaderVerifierSCAddress: testHeaderVerifierSC,
+		DcdtSafeSCAddress:       testDcdtSafeSC,
+		Hasher:                  "sha256",
+		ExpectedChainID:         testChainID,
+		MinWalletBalance:        "10",
+		CheckTimeout:            time.Second,
+	}
+}
+
+func requireResult(t *testing.T, report Report, name string, passed bool, details string) {
+	for _, result := range report.Results {
+		if result.Name != name {
+			continue
+		}
+
+		require.Equal(t, passed, result.Passed, result.Details)
+		require.Contains(t, result.Details, details)
+		return
+	}
+
+	require.Fail(t, "check not found", name)
+}
+
+func TestNewPreflightChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy", func(t *testing.T) {
+		args := createArgs(t)
+		args.Proxy = nil
+
+		pc, err := NewPreflightChecker(args)
+		require.Equal(t, errNilProxy, err)
+		require.Nil(t, pc)
+	})
+	t.Run("invalid check timeout", func(t *testing.T) {
+		args := createArgs(t)
+		args.CheckTimeout = 0
+
+		pc, err := NewPreflightChecker(args)
+		require.ErrorIs(t, err, errInvalidCheckTimeout)
+		require.Nil(t, pc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		pc, err := NewPreflightChecker(createArgs(t))
+		require.Nil(t, err)
+		require.False(t, pc.IsInterfaceNil())
+	})
+}
+
+func TestPreflightChecker_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all checks should pass", func(t *testing.T) {
+		pc, _ := NewPreflightChecker(createArgs(t))
+
+		report := pc.Run(context.Background())
+		require.True(t, report.Passed(), report.String())
+		require.Len(t, report.Results, 7)
+
+		requireResult(t, report, "hasher", true, "sha256")
+		requireResult(t, report, "certificate", true, "days left")
+		requireResult(t, report, "proxy", true, "shard 1 synced at nonce 100")
+		requireResult(t, report, "chain id", true, testChainID)
+		requireResult(t, report, "header verifier contract", true, testHeaderVerifierSC)
+		requireResult(t, report, "dcdt safe contract", true, testDcdtSafeSC)
+		requireResult(t, report, "wallet", true, aliceAddress+", balance 1000")
+	})
+	t.Run("invalid config should fail the checks", func(t *testing.T) {
+		args := createArgs(t)
+		args.Hasher = "md5"
+		args.CertificateConfig = cert.FileCfg{CertFile: "missing.crt", PkFile: "missing.pem"}
+		args.HeaderVerifierSCAddress = "headerVerifier"
+		args.DcdtSafeSCAddress = bobAddress
+		args.ExpectedChainID = ""
+		args.WalletConfig = txSender.WalletConfig{Path: "missing.pem"}
+		pc, _ := NewPreflightChecker(args)
+
+		report := pc.Run(context.Background())
+		require.False(t, report.Passed())
+		require.Equal(t, 6, report.NumFailed())
+
+		requireResult(t, report, "hasher", false, "md5")
+		requireResult(t, report, "certificate", false, "missing.crt")
+		requireResult(t, report, "proxy", true, "shard 0")
+		requireResult(t, report, "chain id", false, errNoExpectedChainID.Error())
+		requireResult(t, report, "header verifier contract", false, "invalid bech32 address")
+		requireResult(t, report, "dcdt safe contract", false, chain.ErrNotSmartContract.Error())
+		requireResult(t, report, "wallet", false, "missing.pem")
+	})
+	t.Run("expired certificate", func(t *testing.T) {
+		args := createArgs(t)
+		args.CertificateConfig = createCertificateFiles(t, 0)
+		pc, _ := NewPreflightChecker(args)
+
+		report := pc.Run(context.Background())
+		requireResult(t, report, "certificate", false, "expired")
+	})
+	t.Run("unreachable proxy should fail all chain checks", func(t *testing.T) {
+		args := createArgs(t)
+		errProxy := errors.New("connection refused")
+		args.Proxy = &testscommon.ProxyMock{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return nil, errProxy
+			},
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, errProxy
+			},
+		}
+		pc, _ := NewPreflightChecker(args)
+
+		report := pc.Run(context.Background())
+		require.Equal(t, 5, report.NumFailed())
+		requireResult(t, report, "hasher", true, "sha256")
+		requireResult(t, report, "certificate", true, "days left")
+		requireResult(t, report, "proxy", false, "proxy is not reachable")
+		requireResult(t, report, "chain id", false, errProxy.Error())
+		requireResult(t, report, "header verifier contract", false, errProxy.Error())
+		requireResult(t, report, "dcdt safe contract", false, errProxy.Error())
+		requireResult(t, report, "wallet", false, errProxy.Error())
+	})
+	t.Run("chain checks", func(t *testing.T) {
+		args := createArgs(t)
+		proxy := createProxyMock()
+		proxy.GetNetworkConfigCalled = func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{ChainID: "D"}, nil
+		}
+		proxy.GetNetworkStatusCalled = func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return &data.NetworkStatus{Nonce: 100, ProbableHighestNonce: 200}, nil
+		}
+		proxy.GetAccountCalled = func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Balance: "5"}, nil
+		}
+		args.Proxy = proxy
+		pc, _ := NewPreflightChecker(args)
+
+		report := pc.Run(context.Background())
+		requireResult(t, report, "proxy", false, errProxyNotSynced.Error())
+		requireResult(t, report, "chain id", false, "proxy chain id D, expected T")
+		requireResult(t, report, "header verifier contract", false, chain.ErrNoContractDeployed.Error())
+		requireResult(t, report, "dcdt safe contract", false, chain.ErrNoContractDeployed.Error())
+		requireResult(t, report, "wallet", false, chain.ErrInsufficientBalance.Error())
+	})
+}
+
+func TestReport_String(t *testing.T) {
+	t.Parallel()
+
+	report := Report{
+		Results: []Result{
+			{Name: "hasher", Passed: true, Details: "sha256"},
+			{Name: "chain id", Passed: false, Details: "chain id mismatch"},
+		},
+	}
+	require.False(t, report.Passed())
+	require.Equal(t, "[PASS] hasher: sha256\n[FAIL] chain id: chain id mismatch\n1 of 2 preflight checks failed\n", report.String())
+
+	report.Results = report.Results[:1]
+	require.True(t, report.Passed())
+	require.Equal(t, "[PASS] hasher: sha256\nall 1 preflight checks passed\n", report.String())
+}
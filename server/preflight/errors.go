@@ -0,0 +1,17 @@
+package preflight
+
+import "errors"
+
+var errNilProxy = errors.New("nil proxy provided")
+
+var errInvalidCheckTimeout = errors.New("invalid check timeout provided")
+
+var errNoExpectedChainID = errors.New("no expected chain id provided")
+
+var errChainIDMismatch = errors.New("chain id mismatch")
+
+var errProxyNotSynced = errors.New("proxy is not synced")
+
+var errInvalidMinWalletBalance = errors.New("invalid min wallet balance provided")
+
+var errCertificateNotValid = errors.New("certificate is not valid")
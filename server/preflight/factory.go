@@ -0,0 +1,36 @@
+package preflight
+
+import (
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/metrics"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+const defaultCheckTimeoutInSec = 10
+
+// CreatePreflightChecker creates the preflight checker for the bridge server config, with its own proxy
+func CreatePreflightChecker(cfg *config.ServerConfig) (*preflightChecker, error) {
+	proxy, err := txSender.CreateProxy(cfg.TxSenderConfig, metrics.NewPrometheusMetrics())
+	if err != nil {
+		return nil, err
+	}
+
+	checkTimeout := cfg.PreflightConfig.CheckTimeoutInSec
+	if checkTimeout <= 0 {
+		checkTimeout = defaultCheckTimeoutInSec
+	}
+
+	return NewPreflightChecker(ArgsPreflightChecker{
+		Proxy:                   proxy,
+		WalletConfig:            cfg.WalletConfig,
+		CertificateConfig:       cfg.CertificateConfig,
+		HeaderVerifierSCAddress: cfg.TxSenderConfig.HeaderVerifierSCAddress,
+		DcdtSafeSCAddress:       cfg.TxSenderConfig.DcdtSafeSCAddress,
+		Hasher:                  cfg.TxSenderConfig.Hasher,
+		ExpectedChainID:         cfg.PreflightConfig.ExpectedChainID,
+		MinWalletBalance:        cfg.HealthConfig.MinWalletBalance,
+		CheckTimeout:            time.Second * time.Duration(checkTimeout),
+	})
+}
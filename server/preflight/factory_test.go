@@ -0,0 +1,51 @@
+package preflight
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/chain"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testkit/fakeProxy"
+)
+
+func TestCreatePreflightChecker_WithFakeProxy(t *testing.T) {
+	t.Parallel()
+
+	httpServer := httptest.NewServer(fakeProxy.NewFakeProxy(fakeProxy.ArgsFakeProxy{ChainID: testChainID}))
+	defer httpServer.Close()
+
+	cfg := &config.ServerConfig{
+		TxSenderConfig: txSender.TxSenderConfig{
+			HeaderVerifierSCAddress: testHeaderVerifierSC,
+			DcdtSafeSCAddress:       testDcdtSafeSC,
+			Proxy:                   httpServer.URL,
+			Hasher:                  "sha256",
+		},
+		WalletConfig:      txSender.WalletConfig{Path: alicePemPath},
+		CertificateConfig: createCertificateFiles(t, 30),
+		PreflightConfig: config.PreflightConfig{
+			ExpectedChainID: testChainID,
+		},
+	}
+
+	pc, err := CreatePreflightChecker(cfg)
+	require.Nil(t, err)
+
+	report := pc.Run(context.Background())
+	require.True(t, report.Passed(), report.String())
+
+	cfg.TxSenderConfig.DcdtSafeSCAddress = bobAddress
+	cfg.PreflightConfig.ExpectedChainID = "D"
+	pc, err = CreatePreflightChecker(cfg)
+	require.Nil(t, err)
+
+	report = pc.Run(context.Background())
+	require.Equal(t, 2, report.NumFailed(), report.String())
+	requireResult(t, report, "chain id", false, errChainIDMismatch.Error())
+	requireResult(t, report, "dcdt safe contract", false, chain.ErrNotSmartContract.Error())
+}
@@ -0,0 +1,56 @@
+package preflight
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Result holds the outcome of one preflight check
+type Result struct {
+	Name    string
+	Passed  bool
+	Details string
+}
+
+// Report holds the outcome of all preflight checks, in the order they were run
+type Report struct {
+	Results []Result
+}
+
+// Passed returns true if all checks passed
+func (r Report) Passed() bool {
+	return r.NumFailed() == 0
+}
+
+// NumFailed returns the number of failed checks
+func (r Report) NumFailed() int {
+	numFailed := 0
+	for _, result := range r.Results {
+		if !result.Passed {
+			numFailed++
+		}
+	}
+
+	return numFailed
+}
+
+// String formats the report with one line per check, followed by a summary line
+func (r Report) String() string {
+	sb := strings.Builder{}
+	for _, result := range r.Results {
+		status := "PASS"
+		if !result.Passed {
+			status = "FAIL"
+		}
+
+		sb.WriteString(fmt.Sprintf("[%s] %s: %s\n", status, result.Name, result.Details))
+	}
+
+	if r.Passed() {
+		sb.WriteString(fmt.Sprintf("all %d preflight checks passed\n", len(r.Results)))
+	} else {
+		sb.WriteString(fmt.Sprintf("%d of %d preflight checks failed\n", r.NumFailed(), len(r.Results)))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,20 @@
+package server
+
+// BridgeOperationsResponseJSON is the JSON representation of sovereign.BridgeOperationsResponse
+type BridgeOperationsResponseJSON struct {
+	TxHashes []string `json:"txHashes"`
+}
+
+// ErrorResponseJSON is the REST endpoint error response, holding the same details as the grpc status error
+type ErrorResponseJSON struct {
+	Code            string               `json:"code"`
+	Message         string               `json:"message"`
+	Reason          string               `json:"reason,omitempty"`
+	FieldViolations []FieldViolationJSON `json:"fieldViolations,omitempty"`
+}
+
+// FieldViolationJSON describes an invalid request field
+type FieldViolationJSON struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
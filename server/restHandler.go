@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/requestID"
+)
+
+const (
+	bridgeOperationsPath = "/bridge/operations"
+	sendFullMethod       = "/sovereign.BridgeTxSender/Send"
+	retryAfterHeader     = "Retry-After"
+)
+
+// registerBridgeOperationsRoute registers the REST endpoint which mirrors the grpc Send. Requests are handled by the
+// same bridge server, through the same interceptors as grpc requests. The body is decoded with operations.FromJSON, the
+// same json representation as the one used by the client. The request id and idempotency key are read
+// from the same headers as the grpc metadata keys.
+func registerBridgeOperationsRoute(router *gin.Engine, bridgeServer sovereign.BridgeTxSenderServer, interceptors []grpc.UnaryServerInterceptor) {
+	handler := chainUnaryInterceptors(interceptors, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return bridgeServer.Send(ctx, req.(*sovereign.BridgeOperations))
+	})
+
+	router.POST(bridgeOperationsPath, requireClientCertificate, func(c *gin.Context) {
+		id := c.GetHeader(requestID.MetadataKey)
+		if len(id) == 0 {
+			id = requestID.New()
+		}
+		c.Header(requestID.MetadataKey, id)
+
+		bridgeOpsJSON := &operations.BridgeOperationsJSON{}
+		err := c.ShouldBindJSON(bridgeOpsJSON)
+		if err != nil {
+			writeErrorResponse(c, &bridgeErrors.ValidationError{Field: "body", Description: err.Error()})
+			return
+		}
+
+		data, err := operations.FromJSON(bridgeOpsJSON)
+		if err != nil {
+			writeErrorResponse(c, err)
+			return
+		}
+
+		ctx := metadata.NewIncomingContext(c.Request.Context(), metadata.Pairs(
+			requestID.MetadataKey, id,
+			idempotency.MetadataKey, c.GetHeader(idempotency.MetadataKey),
+		))
+		ctx = peer.NewContext(ctx, createPeer(c.Request))
+
+		res, err := handler(ctx, data)
+		if err != nil {
+			writeErrorResponse(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, &BridgeOperationsResponseJSON{
+			TxHashes: res.(*sovereign.BridgeOperationsResponse).GetTxHashes(),
+		})
+	})
+}
+
+// chainUnaryInterceptors wraps the handler with the interceptors, in the same order as grpc.ChainUnaryInterceptor
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	info := &grpc.UnaryServerInfo{FullMethod: sendFullMethod}
+
+	chained := handler
+	for idx := len(interceptors) - 1; idx >= 0; idx-- {
+		interceptor := interceptors[idx]
+		next := chained
+		chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	return chained
+}
+
+func createPeer(req *http.Request) *peer.Peer {
+	p := &peer.Peer{
+		Addr: strAddr(req.RemoteAddr),
+	}
+	if req.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *req.TLS}
+	}
+
+	return p
+}
+
+// writeErrorResponse writes the grpc status of the error, with the http status matching its code. Errors which
+// suggest a retry delay also set the Retry-After header.
+func writeErrorResponse(c *gin.Context, err error) {
+	st := status.Convert(bridgeErrors.ToGRPCError(err))
+
+	res := &ErrorResponseJSON{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+		Reason:  bridgeErrors.Reason(st.Err()),
+	}
+	for _, violation := range bridgeErrors.FieldViolations(st.Err()) {
+		res.FieldViolations = append(res.FieldViolations, FieldViolationJSON{
+			Field:       violation.GetField(),
+			Description: violation.GetDescription(),
+		})
+	}
+
+	retryDelay, hasRetryDelay := bridgeErrors.RetryDelay(st.Err())
+	if hasRetryDelay {
+		c.Header(retryAfterHeader, strconv.Itoa(int(math.Ceil(retryDelay.Seconds()))))
+	}
+
+	c.JSON(httpStatusFromCode(st.Code()), res)
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+type strAddr string
+
+// Network returns the address network
+func (a strAddr) Network() string {
+	return "tcp"
+}
+
+// String returns the address
+func (a strAddr) String() string {
+	return string(a)
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/requestID"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+func createBridgeOperations() *sovereign.BridgeOperations {
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("hash"),
+				OutGoingOperations: []*sovereign.OutGoingOperation{
+					{Hash: []byte("opHash"), Data: []byte("opData")},
+				},
+				AggregatedSignature: []byte("aggregatedSig"),
+				LeaderSignature:     []byte("leaderSig"),
+			},
+		},
+	}
+}
+
+func postBridgeOperations(t *testing.T, handler http.Handler, body []byte, header http.Header, authenticated bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, bridgeOperationsPath, bytes.NewReader(body))
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if authenticated {
+		req.TLS = createVerifiedTLSState()
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	return w
+}
+
+func marshalBridgeOperations(t *testing.T, data *sovereign.BridgeOperations) []byte {
+	body, err := operations.MarshalJSON(data)
+	require.Nil(t, err)
+
+	return body
+}
+
+func TestBridgeOperationsRoute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should send through the bridge server and interceptors", func(t *testing.T) {
+		var receivedData *sovereign.BridgeOperations
+		var receivedIdempotencyKey, receivedRequestID string
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				receivedData = data
+				receivedRequestID = requestID.FromContext(ctx)
+				return []string{"txHash1", "txHash2"}, nil
+			},
+		}
+		bridgeServer, _ := NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+		interceptors, _ := NewUnaryInterceptors(&testscommon.LatencyRecorderMock{}, &testscommon.BridgeMetricsMock{})
+		interceptors = append(interceptors, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			require.Equal(t, sendFullMethod, info.FullMethod)
+			receivedIdempotencyKey = idempotency.FromIncomingContext(ctx)
+			return handler(ctx, req)
+		})
+
+		args := createArgsGinHandler()
+		args.BridgeServer = bridgeServer
+		args.Interceptors = interceptors
+		handler, _ := NewGinHandler(args)
+
+		header := http.Header{}
+		header.Set(requestID.MetadataKey, "rest-request-id")
+		header.Set(idempotency.MetadataKey, "idempotency-key")
+		w := postBridgeOperations(t, handler, marshalBridgeOperations(t, createBridgeOperations()), header, true)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "rest-request-id", w.Header().Get(requestID.MetadataKey))
+
+		res := &BridgeOperationsResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, []string{"txHash1", "txHash2"}, res.TxHashes)
+		require.Equal(t, createBridgeOperations().Data[0].OutGoingOperations, receivedData.Data[0].OutGoingOperations)
+		require.Equal(t, "rest-request-id", receivedRequestID)
+		require.Equal(t, "idempotency-key", receivedIdempotencyKey)
+	})
+	t.Run("unauthenticated client should be rejected", func(t *testing.T) {
+		wasCalled := false
+		args := createArgsGinHandler()
+		args.BridgeServer = &testscommon.MockBridgeTxSenderServer{
+			SendCalled: func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				wasCalled = true
+				return &sovereign.BridgeOperationsResponse{}, nil
+			},
+		}
+		handler, _ := NewGinHandler(args)
+
+		w := postBridgeOperations(t, handler, marshalBridgeOperations(t, createBridgeOperations()), nil, false)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.False(t, wasCalled)
+	})
+	t.Run("invalid json should return bad request", func(t *testing.T) {
+		handler, _ := NewGinHandler(createArgsGinHandler())
+
+		w := postBridgeOperations(t, handler, []byte("{"), nil, true)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, codes.InvalidArgument.String(), res.Code)
+		require.Equal(t, bridgeErrors.ReasonValidation, res.Reason)
+	})
+	t.Run("invalid hex field should return the field violation", func(t *testing.T) {
+		handler, _ := NewGinHandler(createArgsGinHandler())
+
+		bridgeOpsJSON := operations.ToJSON(createBridgeOperations())
+		bridgeOpsJSON.Data[0].OutGoingOperations[0].Data = "not hex"
+		body, _ := json.Marshal(bridgeOpsJSON)
+
+		w := postBridgeOperations(t, handler, body, nil, true)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, []FieldViolationJSON{{Field: "data[0].outGoingOperations[0].data", Description: "invalid hex encoding"}}, res.FieldViolations)
+	})
+	t.Run("send error should be mapped to http status", func(t *testing.T) {
+		txSender := &testscommon.TxSenderMock{
+			SendTxsCalled: func(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+				return nil, &bridgeErrors.UnavailableError{Err: errors.New("connection refused")}
+			},
+		}
+		args := createArgsGinHandler()
+		args.BridgeServer, _ = NewSovereignBridgeTxServer(txSender, &testscommon.BridgeOperationsValidatorMock{}, time.Minute)
+		handler, _ := NewGinHandler(args)
+
+		w := postBridgeOperations(t, handler, marshalBridgeOperations(t, createBridgeOperations()), nil, true)
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+		require.Equal(t, "1", w.Header().Get(retryAfterHeader))
+
+		res := &ErrorResponseJSON{}
+		require.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, codes.Unavailable.String(), res.Code)
+		require.Equal(t, bridgeErrors.ReasonUnavailable, res.Reason)
+	})
+}
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, http.StatusOK, httpStatusFromCode(codes.OK))
+	require.Equal(t, http.StatusBadRequest, httpStatusFromCode(codes.InvalidArgument))
+	require.Equal(t, http.StatusConflict, httpStatusFromCode(codes.Aborted))
+	require.Equal(t, http.StatusPreconditionFailed, httpStatusFromCode(codes.FailedPrecondition))
+	require.Equal(t, http.StatusServiceUnavailable, httpStatusFromCode(codes.Unavailable))
+	require.Equal(t, http.StatusGatewayTimeout, httpStatusFromCode(codes.DeadlineExceeded))
+	require.Equal(t, http.StatusInternalServerError, httpStatusFromCode(codes.Internal))
+}
@@ -29,12 +29,19 @@ func NewServerHandler(ginHandler *gin.Engine, grpcHandler *grpc.Server) (*server
 	}, nil
 }
 
-// ServeHTTP will server the http request(http or grpc)
+// ServeHTTP will server the http request(http or grpc). Grpc requests are only served for clients authenticated with
+// a verified certificate.
 func (h *serverRequestsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	contentType := req.Header.Get("Content-Type")
 
 	if strings.HasPrefix(contentType, "application/grpc") {
 		log.Trace("server handling grpc request")
+		if !isClientAuthenticated(req) {
+			log.Debug("rejected unauthenticated grpc request", "client", req.RemoteAddr)
+			writeGRPCUnauthenticated(w)
+			return
+		}
+
 		h.grpcHandler.ServeHTTP(w, req)
 		return
 	}
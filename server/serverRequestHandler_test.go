@@ -3,10 +3,13 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"sync"
 	"testing"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
 )
@@ -94,6 +98,7 @@ func TestServerRequestsHandler_ServeHTTP(t *testing.T) {
 				grpcReq.Header.Set("Content-Type", "application/grpc")
 				grpcReq.ProtoMajor = 2
 				grpcReq.Proto = "HTTP/2.0"
+				grpcReq.TLS = createVerifiedTLSState()
 				grpcW := httptest.NewRecorder()
 				handler.ServeHTTP(grpcW, grpcReq)
 				require.Equal(t, http.StatusOK, grpcW.Code)
@@ -123,3 +128,35 @@ func TestServerRequestsHandler_ServeHTTP(t *testing.T) {
 	grpcServer.Stop()
 	require.True(t, wasSendCalled)
 }
+
+func createVerifiedTLSState() *tls.ConnectionState {
+	return &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{}}},
+	}
+}
+
+func TestServerRequestsHandler_ServeHTTPShouldRejectUnauthenticatedGRPC(t *testing.T) {
+	t.Parallel()
+
+	wasGRPCCalled := false
+	grpcServer := grpc.NewServer()
+	sovereign.RegisterBridgeTxSenderServer(grpcServer, &testscommon.MockBridgeTxSenderServer{
+		SendCalled: func(ctx context.Context, req *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+			wasGRPCCalled = true
+			return &sovereign.BridgeOperationsResponse{}, nil
+		},
+	})
+
+	handler, _ := NewServerHandler(gin.New(), grpcServer)
+
+	req, _ := http.NewRequest("POST", "/sovereign.BridgeTxSender/Send", bytes.NewReader(nil))
+	req.Header.Set("Content-Type", "application/grpc")
+	req.ProtoMajor = 2
+	req.Proto = "HTTP/2.0"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, strconv.Itoa(int(codes.Unauthenticated)), w.Header().Get("Grpc-Status"))
+	require.False(t, wasGRPCCalled)
+}
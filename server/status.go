@@ -0,0 +1,95 @@
+package server
+
+import (
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+// BridgeStatus holds the bridge server status summary, served on the /status endpoint
+type BridgeStatus struct {
+	Version                 string           `json:"version"`
+	ChainID                 string           `json:"chainID"`
+	HeaderVerifierSCAddress string           `json:"headerVerifierSCAddress"`
+	DcdtSafeSCAddress       string           `json:"dcdtSafeSCAddress"`
+	WalletAddress           string           `json:"walletAddress"`
+	Ready                   bool             `json:"ready"`
+	LastSentTx              *txSender.SentTx `json:"lastSentTx,omitempty"`
+	PendingTxs              int              `json:"pendingTxs"`
+	StartTime               time.Time        `json:"startTime"`
+	UptimeInSec             int64            `json:"uptimeInSec"`
+}
+
+// ArgsStatusProvider holds the arguments needed to create a status provider
+type ArgsStatusProvider struct {
+	Version       string
+	WalletAddress string
+	HealthMonitor HealthMonitor
+	TxStats       TxStatsProvider
+	SendingConfig SendingConfigProvider
+}
+
+type statusProvider struct {
+	version       string
+	walletAddress string
+	healthMonitor HealthMonitor
+	txStats       TxStatsProvider
+	sendingConfig SendingConfigProvider
+	startTime     time.Time
+}
+
+// NewStatusProvider creates the provider of the server readiness and status summary. Uptime is measured from its
+// creation.
+func NewStatusProvider(args ArgsStatusProvider) (*statusProvider, error) {
+	if check.IfNil(args.HealthMonitor) {
+		return nil, errNilHealthMonitor
+	}
+	if check.IfNil(args.TxStats) {
+		return nil, errNilTxStatsProvider
+	}
+	if check.IfNil(args.SendingConfig) {
+		return nil, errNilSendingConfigProvider
+	}
+
+	return &statusProvider{
+		version:       args.Version,
+		walletAddress: args.WalletAddress,
+		healthMonitor: args.HealthMonitor,
+		txStats:       args.TxStats,
+		sendingConfig: args.SendingConfig,
+		startTime:     time.Now(),
+	}, nil
+}
+
+// Readiness returns the result of the last readiness check
+func (sp *statusProvider) Readiness() health.Status {
+	return sp.healthMonitor.Status()
+}
+
+// Status returns the server status summary, including the bridge contracts currently used by the tx sender
+func (sp *statusProvider) Status() BridgeStatus {
+	readiness := sp.healthMonitor.Status()
+	txStats := sp.txStats.Stats()
+	sendingConfig := sp.sendingConfig.SendingConfig()
+
+	return BridgeStatus{
+		Version:                 sp.version,
+		ChainID:                 readiness.ChainID,
+		HeaderVerifierSCAddress: sendingConfig.HeaderVerifierSCAddress,
+		DcdtSafeSCAddress:       sendingConfig.DcdtSafeSCAddress,
+		WalletAddress:           sp.walletAddress,
+		Ready:                   readiness.Ready,
+		LastSentTx:              txStats.LastSentTx,
+		PendingTxs:              txStats.PendingTxs,
+		StartTime:               sp.startTime,
+		UptimeInSec:             int64(time.Since(sp.startTime).Seconds()),
+	}
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (sp *statusProvider) IsInterfaceNil() bool {
+	return sp == nil
+}
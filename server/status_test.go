@@ -0,0 +1,95 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/adminMocks"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon/serverMocks"
+)
+
+func createArgsStatusProvider() ArgsStatusProvider {
+	return ArgsStatusProvider{
+		Version:       "v1.0.0",
+		WalletAddress: "wallet",
+		HealthMonitor: &serverMocks.HealthMonitorMock{
+			StatusCalled: func() health.Status {
+				return health.Status{Ready: true, ChainID: "T"}
+			},
+		},
+		TxStats: &serverMocks.TxStatsProviderMock{
+			StatsCalled: func() txSender.Stats {
+				return txSender.Stats{
+					PendingTxs: 3,
+					LastSentTx: &txSender.SentTx{Hash: "txHash", Nonce: 7},
+				}
+			},
+		},
+		SendingConfig: &adminMocks.SendingConfigUpdaterMock{
+			SendingConfigCalled: func() txSender.SendingConfig {
+				return txSender.SendingConfig{
+					HeaderVerifierSCAddress: "headerVerifier",
+					DcdtSafeSCAddress:       "dcdtSafe",
+				}
+			},
+		},
+	}
+}
+
+func TestNewStatusProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil health monitor", func(t *testing.T) {
+		args := createArgsStatusProvider()
+		args.HealthMonitor = nil
+
+		sp, err := NewStatusProvider(args)
+		require.Equal(t, errNilHealthMonitor, err)
+		require.Nil(t, sp)
+	})
+	t.Run("nil tx stats provider", func(t *testing.T) {
+		args := createArgsStatusProvider()
+		args.TxStats = nil
+
+		sp, err := NewStatusProvider(args)
+		require.Equal(t, errNilTxStatsProvider, err)
+		require.Nil(t, sp)
+	})
+	t.Run("nil sending config provider", func(t *testing.T) {
+		args := createArgsStatusProvider()
+		args.SendingConfig = nil
+
+		sp, err := NewStatusProvider(args)
+		require.Equal(t, errNilSendingConfigProvider, err)
+		require.Nil(t, sp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		sp, err := NewStatusProvider(createArgsStatusProvider())
+		require.Nil(t, err)
+		require.False(t, sp.IsInterfaceNil())
+	})
+}
+
+func TestStatusProvider_Status(t *testing.T) {
+	t.Parallel()
+
+	sp, _ := NewStatusProvider(createArgsStatusProvider())
+	sp.startTime = time.Now().Add(-time.Minute)
+
+	status := sp.Status()
+	require.Equal(t, "v1.0.0", status.Version)
+	require.Equal(t, "T", status.ChainID)
+	require.Equal(t, "headerVerifier", status.HeaderVerifierSCAddress)
+	require.Equal(t, "dcdtSafe", status.DcdtSafeSCAddress)
+	require.Equal(t, "wallet", status.WalletAddress)
+	require.True(t, status.Ready)
+	require.Equal(t, &txSender.SentTx{Hash: "txHash", Nonce: 7}, status.LastSentTx)
+	require.Equal(t, 3, status.PendingTxs)
+	require.GreaterOrEqual(t, status.UptimeInSec, int64(60))
+
+	require.Equal(t, health.Status{Ready: true, ChainID: "T"}, sp.Readiness())
+}
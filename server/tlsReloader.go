@@ -0,0 +1,86 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"sync/atomic"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+)
+
+// nextProtos are the protocols negotiated over tls, http2 being required by grpc
+var nextProtos = []string{"h2", "http/1.1"}
+
+type tlsReloader struct {
+	tlsConfig atomic.Pointer[tls.Config]
+}
+
+// NewTLSReloader loads the server certificate, along with the client CAs created from it, which can be reloaded later
+// without restarting the server
+func NewTLSReloader(cfg cert.FileCfg) (*tlsReloader, error) {
+	tr := &tlsReloader{}
+	err := tr.Reload(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return tr, nil
+}
+
+// Reload loads the certificate files, used starting with the next tls handshakes. Established connections are kept. If
+// the files can not be loaded, the previous certificate is kept.
+func (tr *tlsReloader) Reload(cfg cert.FileCfg) error {
+	tlsConfig, err := cert.LoadTLSServerConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	tr.SetTLSConfig(tlsConfig)
+	return nil
+}
+
+// SetTLSConfig replaces the server tls config, loaded with cert.LoadTLSServerConfig, used starting with the next tls
+// handshakes
+func (tr *tlsReloader) SetTLSConfig(tlsConfig *tls.Config) {
+	tr.tlsConfig.Store(tlsConfig)
+}
+
+// TLSConfig returns a server tls config, with the provided client authentication, which always uses the last loaded
+// certificate
+func (tr *tlsReloader) TLSConfig(clientAuth tls.ClientAuthType) *tls.Config {
+	return &tls.Config{
+		ClientAuth: clientAuth,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			tlsConfig := tr.tlsConfig.Load().Clone()
+			tlsConfig.ClientAuth = clientAuth
+			tlsConfig.NextProtos = nextProtos
+
+			return tlsConfig, nil
+		},
+	}
+}
+
+// CertificateExpiry returns the expiry time of the last loaded certificate
+func (tr *tlsReloader) CertificateExpiry() (time.Time, error) {
+	return certificateExpiry(tr.tlsConfig.Load())
+}
+
+func certificateExpiry(tlsConfig *tls.Config) (time.Time, error) {
+	if len(tlsConfig.Certificates) == 0 || len(tlsConfig.Certificates[0].Certificate) == 0 {
+		return time.Time{}, errNoServerCertificate
+	}
+
+	certificate, err := x509.ParseCertificate(tlsConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return certificate.NotAfter, nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (tr *tlsReloader) IsInterfaceNil() bool {
+	return tr == nil
+}
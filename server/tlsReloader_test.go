@@ -0,0 +1,111 @@
+package server
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/cert"
+)
+
+func createCertificateFiles(t *testing.T, availabilityInDays int64) cert.FileCfg {
+	dir := t.TempDir()
+	fileCfg := cert.FileCfg{
+		CertFile: filepath.Join(dir, "certificate.crt"),
+		PkFile:   filepath.Join(dir, "private_key.pem"),
+	}
+
+	err := cert.GenerateCertFiles(cert.CertificateCfg{
+		CertCfg: cert.CertCfg{
+			Organization: "test",
+			DNSName:      "localhost",
+			IPAddress:    "127.0.0.1",
+			Availability: availabilityInDays,
+		},
+		CertFileCfg: fileCfg,
+	})
+	require.Nil(t, err)
+
+	return fileCfg
+}
+
+func requireHTTPSRequest(t *testing.T, url string, clientCertificate cert.FileCfg, shouldSucceed bool) {
+	clientTLSConfig, err := cert.LoadTLSClientConfig(clientCertificate)
+	require.Nil(t, err)
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   clientTLSConfig,
+			ForceAttemptHTTP2: true,
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Get(url)
+	if !shouldSucceed {
+		require.NotNil(t, err)
+		return
+	}
+
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 2, resp.ProtoMajor)
+}
+
+func TestNewTLSReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing certificate files", func(t *testing.T) {
+		tr, err := NewTLSReloader(cert.FileCfg{CertFile: "missing.crt", PkFile: "missing.pem"})
+		require.NotNil(t, err)
+		require.Nil(t, tr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		tr, err := NewTLSReloader(createCertificateFiles(t, 30))
+		require.Nil(t, err)
+		require.False(t, tr.IsInterfaceNil())
+
+		expiry, err := tr.CertificateExpiry()
+		require.Nil(t, err)
+		require.WithinDuration(t, time.Now().Add(30*24*time.Hour), expiry, time.Hour)
+	})
+}
+
+func TestTLSReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	firstCertificate := createCertificateFiles(t, 30)
+	tr, _ := NewTLSReloader(firstCertificate)
+
+	httpServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	httpServer.EnableHTTP2 = true
+	httpServer.TLS = tr.TLSConfig(tls.RequireAndVerifyClientCert)
+	httpServer.StartTLS()
+	defer httpServer.Close()
+
+	requireHTTPSRequest(t, httpServer.URL, firstCertificate, true)
+
+	err := tr.Reload(cert.FileCfg{CertFile: "missing.crt", PkFile: "missing.pem"})
+	require.NotNil(t, err)
+	requireHTTPSRequest(t, httpServer.URL, firstCertificate, true)
+
+	secondCertificate := createCertificateFiles(t, 60)
+	err = tr.Reload(secondCertificate)
+	require.Nil(t, err)
+
+	// the client CAs are created from the server certificate, so clients should use the new one
+	requireHTTPSRequest(t, httpServer.URL, secondCertificate, true)
+	requireHTTPSRequest(t, httpServer.URL, firstCertificate, false)
+
+	expiry, err := tr.CertificateExpiry()
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(60*24*time.Hour), expiry, time.Hour)
+}
@@ -10,7 +10,10 @@ type WalletConfig struct {
 type TxSenderConfig struct {
 	HeaderVerifierSCAddress string
 	DcdtSafeSCAddress       string
+	RegisterGas             GasConfig
+	ExecuteGas              GasConfig
 	Proxy                   string
 	IntervalToSend          int
 	Hasher                  string
+	NumWorkers              int
 }
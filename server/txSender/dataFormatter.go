@@ -8,6 +8,8 @@ import (
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
 )
 
 const (
@@ -15,6 +17,13 @@ const (
 	executeBridgeOpsPrefix  = "executeBridgeOps"
 )
 
+const (
+	// RegisterEndpoint is the metrics label of txs calling the header verifier sc to register bridge operations
+	RegisterEndpoint = "register"
+	// ExecuteEndpoint is the metrics label of txs calling the dcdt safe sc to execute bridge operations
+	ExecuteEndpoint = "execute"
+)
+
 type dataFormatter struct {
 	hasher hashing.Hasher
 }
@@ -52,15 +61,13 @@ func (df *dataFormatter) CreateTxsData(data *sovereign.BridgeOperations) [][]byt
 }
 
 func (df *dataFormatter) createRegisterBridgeOperationsData(bridgeData *sovereign.BridgeOutGoingData) []byte {
-	hashes := make([]byte, 0)
 	hashesHexEncodedArgs := make([]byte, 0)
 	for _, operation := range bridgeData.OutGoingOperations {
 		hashesHexEncodedArgs = append(hashesHexEncodedArgs, "@"+hex.EncodeToString(operation.Hash)...)
-		hashes = append(hashes, operation.Hash...)
 	}
 
 	// unconfirmed operation, should not register it, only resend it
-	computedHashOfHashes := df.hasher.Compute(string(hashes))
+	computedHashOfHashes := operations.ComputeHashOfHashes(df.hasher, bridgeData.OutGoingOperations)
 	if !bytes.Equal(bridgeData.Hash, computedHashOfHashes) {
 		return nil
 	}
@@ -0,0 +1,223 @@
+package txSender
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	coreTx "github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/requestID"
+)
+
+const dispatchQueueSize = 1024
+
+type batchResult struct {
+	hashes []string
+	err    error
+}
+
+// txBatch holds all txs created from one bridge operations request. Txs from the same batch are always assigned
+// consecutive nonces and are broadcast together.
+type txBatch struct {
+	ctx    context.Context
+	txs    []*coreTx.FrontendTransaction
+	result chan *batchResult
+
+	mutSigning sync.Mutex
+	numSigned  int
+	signErr    error
+	signed     chan struct{}
+}
+
+func newTxBatch(ctx context.Context, txs []*coreTx.FrontendTransaction) *txBatch {
+	return &txBatch{
+		ctx:    ctx,
+		txs:    txs,
+		result: make(chan *batchResult, 1),
+		signed: make(chan struct{}),
+	}
+}
+
+func (b *txBatch) markSigned(err error) {
+	b.mutSigning.Lock()
+	defer b.mutSigning.Unlock()
+
+	if err != nil && b.signErr == nil {
+		b.signErr = err
+	}
+
+	b.numSigned++
+	if b.numSigned == len(b.txs) {
+		close(b.signed)
+	}
+}
+
+func (b *txBatch) finish(hashes []string, err error) {
+	b.result <- &batchResult{
+		hashes: hashes,
+		err:    err,
+	}
+}
+
+// dispatchBatches is the only goroutine which assigns nonces for the wallet. Once a batch has its nonces, it is
+// scheduled for signing on the workers and queued for broadcast, keeping the nonce order. While sending is paused,
+// received batches are held before their nonces are assigned.
+func (ts *txSender) dispatchBatches() {
+	defer ts.wgLoops.Done()
+
+	for {
+		select {
+		case <-ts.ctx.Done():
+			return
+		case batch := <-ts.dispatchQueue:
+			ts.metrics.SetQueueDepth(len(ts.dispatchQueue))
+			if !ts.waitUntilResumed() {
+				ts.finishBatch(batch, nil, errTxSenderClosed)
+				return
+			}
+
+			ts.dispatch(batch)
+		}
+	}
+}
+
+// waitUntilResumed blocks while sending is paused, returning false if the tx sender was closed meanwhile
+func (ts *txSender) waitUntilResumed() bool {
+	select {
+	case <-ts.pauseGate.resumedChan():
+		return true
+	case <-ts.ctx.Done():
+		return false
+	}
+}
+
+func (ts *txSender) dispatch(batch *txBatch) {
+	// caller gave up while the batch was queued, no nonce should be consumed for it
+	if batch.ctx.Err() != nil {
+		ts.finishBatch(batch, nil, batch.ctx.Err())
+		return
+	}
+
+	// the sending config is loaded once per batch, so that a config change applies between batches
+	sendingConfig := ts.sendingConfig.Load()
+	for _, tx := range batch.txs {
+		sendingConfig.apply(tx, ts.netConfigs.MinGasPrice)
+	}
+
+	err := ts.txNonceHandler.ApplyNonceAndGasPrice(batch.ctx, batch.txs...)
+	if err != nil {
+		log.Debug("failed to apply nonces", "request id", requestID.FromContext(batch.ctx), "error", err)
+		ts.finishBatch(batch, nil, err)
+		return
+	}
+
+	log.Debug("assigned nonces",
+		"request id", requestID.FromContext(batch.ctx),
+		"first nonce", batch.txs[0].Nonce,
+		"num txs", len(batch.txs))
+	ts.metrics.SetNonce(batch.txs[len(batch.txs)-1].Nonce)
+
+	ts.signTxs(batch)
+
+	select {
+	case ts.broadcastQueue <- batch:
+	case <-ts.ctx.Done():
+		ts.finishBatch(batch, nil, errTxSenderClosed)
+	}
+}
+
+func (ts *txSender) signTxs(batch *txBatch) {
+	for _, tx := range batch.txs {
+		txToSign := tx
+		wasScheduled := ts.workers.submit(ts.ctx, func() {
+			batch.markSigned(ts.txInteractor.ApplyUserSignature(ts.wallet, txToSign))
+		})
+		if !wasScheduled {
+			return
+		}
+	}
+}
+
+// broadcastBatches sends the batches in the same order in which they were assigned nonces
+func (ts *txSender) broadcastBatches() {
+	defer ts.wgLoops.Done()
+
+	for {
+		select {
+		case <-ts.ctx.Done():
+			return
+		case batch := <-ts.broadcastQueue:
+			ts.broadcast(batch)
+		}
+	}
+}
+
+func (ts *txSender) broadcast(batch *txBatch) {
+	select {
+	case <-batch.signed:
+	case <-ts.ctx.Done():
+		ts.finishBatch(batch, nil, errTxSenderClosed)
+		return
+	}
+
+	if batch.signErr != nil {
+		ts.finishBatch(batch, nil, batch.signErr)
+		return
+	}
+
+	// nonces are already consumed, so txs are sent even if the caller is no longer waiting for them
+	hashes, err := ts.txNonceHandler.SendTransactions(ts.ctx, batch.txs...)
+	if err != nil {
+		log.Error("failed to send txs",
+			"request id", requestID.FromContext(batch.ctx),
+			"error", err,
+			"first nonce", batch.txs[0].Nonce,
+			"num txs", len(batch.txs))
+		ts.finishBatch(batch, nil, err)
+		return
+	}
+
+	ts.addSentTxs(batch.txs)
+	ts.setLastSentTx(hashes, batch.txs)
+	ts.finishBatch(batch, hashes, nil)
+}
+
+func (ts *txSender) finishBatch(batch *txBatch, hashes []string, err error) {
+	ts.pendingTxs.Add(-int64(len(batch.txs)))
+	batch.finish(hashes, err)
+}
+
+func (ts *txSender) setLastSentTx(hashes []string, txs []*coreTx.FrontendTransaction) {
+	if len(hashes) == 0 || len(hashes) != len(txs) {
+		return
+	}
+
+	ts.mutLastTx.Lock()
+	ts.lastSentTx = &SentTx{
+		Hash:      hashes[len(hashes)-1],
+		Nonce:     txs[len(txs)-1].Nonce,
+		Timestamp: time.Now(),
+	}
+	ts.mutLastTx.Unlock()
+}
+
+func (ts *txSender) addSentTxs(txs []*coreTx.FrontendTransaction) {
+	numTxsPerEndpoint := make(map[string]int)
+	for _, tx := range txs {
+		numTxsPerEndpoint[txEndpoint(tx)]++
+	}
+
+	for endpoint, numTxs := range numTxsPerEndpoint {
+		ts.metrics.AddSentTxs(endpoint, numTxs)
+	}
+}
+
+func txEndpoint(tx *coreTx.FrontendTransaction) string {
+	if bytes.HasPrefix(tx.Data, []byte(registerBridgeOpsPrefix)) {
+		return RegisterEndpoint
+	}
+
+	return ExecuteEndpoint
+}
@@ -14,6 +14,18 @@ var errNilDataFormatter = errors.New("nil data formatter provided")
 
 var errNilNonceHandler = errors.New("nil nonce handler provided")
 
+var errNilMetrics = errors.New("nil metrics provided")
+
+var errNilProxyLatencyRecorder = errors.New("nil proxy latency recorder provided")
+
 var errNoHeaderVerifierSCAddress = errors.New("no header verifier sc address provided")
 
 var errNoDcdtSafeSCAddress = errors.New("no dcdt safe sc address provided")
+
+var errInvalidGasLimit = errors.New("invalid gas limit provided")
+
+var errInvalidGasPriceMultiplier = errors.New("invalid gas price multiplier provided")
+
+var errInvalidNumWorkers = errors.New("invalid number of workers provided")
+
+var errTxSenderClosed = errors.New("tx sender is closed")
@@ -3,6 +3,7 @@ package txSender
 import (
 	"time"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
 	"github.com/TerraDharitri/drt-go-sdk/blockchain"
 	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
@@ -12,22 +13,27 @@ import (
 	"github.com/TerraDharitri/drt-go-sdk/interactors/nonceHandlerV3"
 )
 
-// CreateTxSender creates a new transactions sender
-func CreateTxSender(wallet core.CryptoComponentsHolder, cfg TxSenderConfig) (*txSender, error) {
+// CreateProxy creates the proxy used to interact with Dharitri blockchain. The duration of each proxy call is recorded.
+func CreateProxy(cfg TxSenderConfig, latencyRecorder ProxyLatencyRecorder) (NetworkProxy, error) {
+	if check.IfNil(latencyRecorder) {
+		return nil, errNilProxyLatencyRecorder
+	}
+
 	args := blockchain.ArgsProxy{
 		ProxyURL:            cfg.Proxy,
-		Client:              nil,
+		Client:              newInstrumentedHTTPClient(latencyRecorder),
 		SameScState:         false,
 		ShouldBeSynced:      false,
 		FinalityCheck:       false,
 		CacheExpirationTime: time.Minute,
 		EntityType:          core.Proxy,
 	}
-	proxy, err := blockchain.NewProxy(args)
-	if err != nil {
-		return nil, err
-	}
 
+	return blockchain.NewProxy(args)
+}
+
+// CreateTxSender creates a new transactions sender, which sends txs through the provided proxy
+func CreateTxSender(wallet core.CryptoComponentsHolder, proxy interactors.Proxy, cfg TxSenderConfig, metrics Metrics) (*txSender, error) {
 	nonceHandler, err := nonceHandlerV3.NewNonceTransactionHandlerV3(nonceHandlerV3.ArgsNonceTransactionsHandlerV3{
 		Proxy:          proxy,
 		IntervalToSend: time.Millisecond * time.Duration(cfg.IntervalToSend),
@@ -62,7 +68,11 @@ func CreateTxSender(wallet core.CryptoComponentsHolder, cfg TxSenderConfig) (*tx
 		TxInteractor:            ti,
 		TxNonceHandler:          nonceHandler,
 		DataFormatter:           dtaFormatter,
+		Metrics:                 metrics,
 		SCHeaderVerifierAddress: cfg.HeaderVerifierSCAddress,
 		SCDcdtSafeAddress:       cfg.DcdtSafeSCAddress,
+		RegisterGas:             cfg.RegisterGas,
+		ExecuteGas:              cfg.ExecuteGas,
+		NumWorkers:              cfg.NumWorkers,
 	})
 }
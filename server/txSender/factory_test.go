@@ -0,0 +1,128 @@
+package txSender
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testkit/fakeProxy"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+const (
+	alicePemPath = "testData/alice.pem"
+	aliceAddress = "drt1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssey5egf"
+	bobAddress   = "drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"
+)
+
+func TestCreateTxSender_WithFakeProxy(t *testing.T) {
+	t.Parallel()
+
+	proxy := fakeProxy.NewFakeProxy(fakeProxy.ArgsFakeProxy{})
+	httpServer := httptest.NewServer(proxy)
+	defer httpServer.Close()
+
+	wallet, err := LoadWallet(WalletConfig{Path: alicePemPath})
+	require.Nil(t, err)
+
+	// the account already sent txs before the bridge server started
+	_, balance := proxy.GetAccount(aliceAddress)
+	proxy.SetAccount(aliceAddress, 42, balance)
+
+	cfg := TxSenderConfig{
+		HeaderVerifierSCAddress: bobAddress,
+		DcdtSafeSCAddress:       aliceAddress,
+		Proxy:                   httpServer.URL,
+		IntervalToSend:          1,
+		Hasher:                  "sha256",
+		NumWorkers:              2,
+	}
+	_, err = CreateProxy(cfg, nil)
+	require.Equal(t, errNilProxyLatencyRecorder, err)
+
+	proxyCalls := &sync.Map{}
+	sdkProxy, err := CreateProxy(cfg, &testscommon.ProxyLatencyRecorderMock{
+		RecordProxyLatencyCalled: func(call string, _ time.Duration) {
+			proxyCalls.Store(call, struct{}{})
+		},
+	})
+	require.Nil(t, err)
+
+	mutMetrics := sync.Mutex{}
+	sentTxs := make(map[string]int)
+	lastNonce := uint64(0)
+	ts, err := CreateTxSender(wallet, sdkProxy, cfg, &testscommon.TxSenderMetricsMock{
+		AddSentTxsCalled: func(endpoint string, numTxs int) {
+			mutMetrics.Lock()
+			sentTxs[endpoint] += numTxs
+			mutMetrics.Unlock()
+		},
+		SetNonceCalled: func(nonce uint64) {
+			mutMetrics.Lock()
+			lastNonce = nonce
+			mutMetrics.Unlock()
+		},
+	})
+	require.Nil(t, err)
+	defer ts.Close()
+
+	hasher, _ := factory.NewHasher("sha256")
+	bridgeData := &sovereign.BridgeOutGoingData{
+		OutGoingOperations: []*sovereign.OutGoingOperation{
+			{Hash: []byte("opHash1"), Data: []byte("opData1")},
+			{Hash: []byte("opHash2"), Data: []byte("opData2")},
+		},
+		AggregatedSignature: []byte("aggregatedSig"),
+	}
+	bridgeData.Hash = operations.ComputeHashOfHashes(hasher, bridgeData.OutGoingOperations)
+
+	hashes, err := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{bridgeData},
+	})
+	require.Nil(t, err)
+	require.Len(t, hashes, 3)
+
+	for _, hash := range hashes {
+		status, errStatus := proxy.GetTransactionStatus(hash)
+		require.Nil(t, errStatus)
+		require.Equal(t, transaction.TxStatusSuccess, status)
+	}
+
+	executedTxs := proxy.GetExecutedTransactions()
+	require.Len(t, executedTxs, 3)
+	for idx, tx := range executedTxs {
+		require.Equal(t, uint64(42+idx), tx.Nonce)
+		require.Equal(t, aliceAddress, tx.Sender)
+	}
+	require.True(t, strings.HasPrefix(string(executedTxs[0].Data), registerBridgeOpsPrefix))
+	require.Equal(t, bobAddress, executedTxs[0].Receiver)
+	require.True(t, strings.HasPrefix(string(executedTxs[1].Data), executeBridgeOpsPrefix))
+	require.Equal(t, aliceAddress, executedTxs[1].Receiver)
+
+	nonce, _ := proxy.GetAccount(aliceAddress)
+	require.Equal(t, uint64(45), nonce)
+
+	stats := ts.Stats()
+	require.Zero(t, stats.PendingTxs)
+	require.Equal(t, hashes[2], stats.LastSentTx.Hash)
+	require.Equal(t, uint64(44), stats.LastSentTx.Nonce)
+
+	mutMetrics.Lock()
+	require.Equal(t, map[string]int{RegisterEndpoint: 1, ExecuteEndpoint: 2}, sentTxs)
+	require.Equal(t, uint64(44), lastNonce)
+	mutMetrics.Unlock()
+
+	_, found := proxyCalls.Load("/network/config")
+	require.True(t, found)
+	_, found = proxyCalls.Load("/transaction/send")
+	require.True(t, found)
+}
@@ -2,11 +2,13 @@ package txSender
 
 import (
 	"context"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 	"github.com/TerraDharitri/drt-go-sdk/core"
 	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/interactors"
 )
 
 // TxInteractor defines a tx interactor with dharitri blockchain
@@ -22,6 +24,13 @@ type Proxy interface {
 	IsInterfaceNil() bool
 }
 
+// NetworkProxy defines the proxy created for the bridge server, which also provides the network status of each shard
+type NetworkProxy interface {
+	interactors.Proxy
+	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error)
+}
+
 // DataFormatter should format txs data for bridge operations
 type DataFormatter interface {
 	CreateTxsData(data *sovereign.BridgeOperations) [][]byte
@@ -34,3 +43,17 @@ type TxNonceSenderHandler interface {
 	SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error)
 	IsInterfaceNil() bool
 }
+
+// Metrics defines the metrics updated by the tx sender
+type Metrics interface {
+	AddSentTxs(endpoint string, numTxs int)
+	SetNonce(nonce uint64)
+	SetQueueDepth(depth int)
+	IsInterfaceNil() bool
+}
+
+// ProxyLatencyRecorder defines a recorder for proxy call durations
+type ProxyLatencyRecorder interface {
+	RecordProxyLatency(call string, duration time.Duration)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,45 @@
+package txSender
+
+import "sync"
+
+// pauseGate holds the dispatcher while sending is paused. The resumed channel is closed while not paused.
+type pauseGate struct {
+	mut     sync.RWMutex
+	paused  bool
+	resumed chan struct{}
+}
+
+func newPauseGate() *pauseGate {
+	resumed := make(chan struct{})
+	close(resumed)
+
+	return &pauseGate{
+		resumed: resumed,
+	}
+}
+
+// setPaused returns true if the paused state changed
+func (pg *pauseGate) setPaused(paused bool) bool {
+	pg.mut.Lock()
+	defer pg.mut.Unlock()
+
+	if pg.paused == paused {
+		return false
+	}
+
+	pg.paused = paused
+	if paused {
+		pg.resumed = make(chan struct{})
+		return true
+	}
+
+	close(pg.resumed)
+	return true
+}
+
+func (pg *pauseGate) resumedChan() <-chan struct{} {
+	pg.mut.RLock()
+	defer pg.mut.RUnlock()
+
+	return pg.resumed
+}
@@ -0,0 +1,60 @@
+package txSender
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const unknownProxyCall = "other"
+
+// proxyCalls holds the proxy routes with a fixed path, such as tx sending. Routes with addresses or hashes in their
+// path are reported by their first path segment, to keep the number of metric labels bounded.
+var proxyCalls = map[string]struct{}{
+	"/network/config":            {},
+	"/network/status":            {},
+	"/transaction/send":          {},
+	"/transaction/send-multiple": {},
+	"/transaction/simulate":      {},
+	"/transaction/cost":          {},
+}
+
+type instrumentedHTTPClient struct {
+	client          *http.Client
+	latencyRecorder ProxyLatencyRecorder
+}
+
+func newInstrumentedHTTPClient(latencyRecorder ProxyLatencyRecorder) *instrumentedHTTPClient {
+	return &instrumentedHTTPClient{
+		client:          http.DefaultClient,
+		latencyRecorder: latencyRecorder,
+	}
+}
+
+// Do sends the http request and records its duration
+func (c *instrumentedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := c.client.Do(req)
+	c.latencyRecorder.RecordProxyLatency(proxyCallName(req), time.Since(start))
+
+	return res, err
+}
+
+func proxyCallName(req *http.Request) string {
+	if req.URL == nil {
+		return unknownProxyCall
+	}
+
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	_, isKnownCall := proxyCalls[path]
+	if isKnownCall {
+		return path
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	if len(segments[0]) == 0 {
+		return unknownProxyCall
+	}
+
+	return "/" + segments[0]
+}
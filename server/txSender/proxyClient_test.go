@@ -0,0 +1,25 @@
+package txSender
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyCallName(t *testing.T) {
+	t.Parallel()
+
+	callName := func(url string) string {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.Nil(t, err)
+
+		return proxyCallName(req)
+	}
+
+	require.Equal(t, "/network/config", callName("http://proxy/network/config"))
+	require.Equal(t, "/transaction/send-multiple", callName("http://proxy/transaction/send-multiple/"))
+	require.Equal(t, "/address", callName("http://proxy/address/drt1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqlqde3c"))
+	require.Equal(t, "/transaction", callName("http://proxy/transaction/abcd/status"))
+	require.Equal(t, unknownProxyCall, callName("http://proxy/"))
+}
@@ -0,0 +1,94 @@
+package txSender
+
+import (
+	"fmt"
+
+	coreTx "github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+)
+
+const (
+	defaultGasLimit           = 50_000_000
+	defaultGasPriceMultiplier = 1
+	maxGasPriceMultiplier     = 10
+)
+
+// GasConfig holds the gas settings of the txs sent to one of the bridge contracts. The gas price is the network min gas
+// price multiplied by GasPriceMultiplier.
+type GasConfig struct {
+	GasLimit           uint64  `json:"gasLimit"`
+	GasPriceMultiplier float64 `json:"gasPriceMultiplier"`
+}
+
+// SendingConfig holds the bridge contracts and the gas settings of the sent txs, which can be changed at runtime. Txs
+// registering bridge operations are sent to the header verifier contract, the ones executing them to the dcdt safe.
+type SendingConfig struct {
+	HeaderVerifierSCAddress string    `json:"headerVerifierSCAddress"`
+	DcdtSafeSCAddress       string    `json:"dcdtSafeSCAddress"`
+	RegisterGas             GasConfig `json:"registerGas"`
+	ExecuteGas              GasConfig `json:"executeGas"`
+}
+
+// ApplyGasDefaults returns the gas settings with the zero values replaced with the defaults
+func ApplyGasDefaults(cfg GasConfig) GasConfig {
+	if cfg.GasLimit == 0 {
+		cfg.GasLimit = defaultGasLimit
+	}
+	if cfg.GasPriceMultiplier == 0 {
+		cfg.GasPriceMultiplier = defaultGasPriceMultiplier
+	}
+
+	return cfg
+}
+
+// Check verifies that the contracts are set and that the gas settings are within limits. It does not check that the
+// contracts are deployed.
+func (sc SendingConfig) Check() error {
+	if len(sc.HeaderVerifierSCAddress) == 0 {
+		return errNoHeaderVerifierSCAddress
+	}
+	if len(sc.DcdtSafeSCAddress) == 0 {
+		return errNoDcdtSafeSCAddress
+	}
+
+	err := sc.RegisterGas.check()
+	if err != nil {
+		return fmt.Errorf("register gas: %w", err)
+	}
+
+	err = sc.ExecuteGas.check()
+	if err != nil {
+		return fmt.Errorf("execute gas: %w", err)
+	}
+
+	return nil
+}
+
+// CheckGasConfig verifies that the gas settings are within limits, zero values being replaced with the defaults
+func CheckGasConfig(cfg GasConfig) error {
+	return ApplyGasDefaults(cfg).check()
+}
+
+func (gc GasConfig) check() error {
+	if gc.GasLimit == 0 {
+		return fmt.Errorf("%w: %d", errInvalidGasLimit, gc.GasLimit)
+	}
+	if gc.GasPriceMultiplier < 1 || gc.GasPriceMultiplier > maxGasPriceMultiplier {
+		return fmt.Errorf("%w: %v, should be between 1 and %d", errInvalidGasPriceMultiplier, gc.GasPriceMultiplier, maxGasPriceMultiplier)
+	}
+
+	return nil
+}
+
+// apply sets the receiver and the gas of the tx, based on the bridge endpoint it calls
+func (sc *SendingConfig) apply(tx *coreTx.FrontendTransaction, minGasPrice uint64) {
+	receiver := sc.DcdtSafeSCAddress
+	gasConfig := sc.ExecuteGas
+	if txEndpoint(tx) == RegisterEndpoint {
+		receiver = sc.HeaderVerifierSCAddress
+		gasConfig = sc.RegisterGas
+	}
+
+	tx.Receiver = receiver
+	tx.GasLimit = gasConfig.GasLimit
+	tx.GasPrice = uint64(float64(minGasPrice) * gasConfig.GasPriceMultiplier)
+}
@@ -2,15 +2,24 @@ package txSender
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
 	coreTx "github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 	"github.com/TerraDharitri/drt-go-sdk/core"
 	"github.com/TerraDharitri/drt-go-sdk/data"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
 )
 
+const shutdownCheckInterval = time.Millisecond * 50
+
 // TxSenderArgs holds args to create a new tx sender
 type TxSenderArgs struct {
 	Wallet                  core.CryptoComponentsHolder
@@ -18,18 +27,48 @@ type TxSenderArgs struct {
 	TxInteractor            TxInteractor
 	TxNonceHandler          TxNonceSenderHandler
 	DataFormatter           DataFormatter
+	Metrics                 Metrics
 	SCHeaderVerifierAddress string
 	SCDcdtSafeAddress       string
+	RegisterGas             GasConfig
+	ExecuteGas              GasConfig
+	NumWorkers              int
 }
 
 type txSender struct {
-	wallet                  core.CryptoComponentsHolder
-	netConfigs              *data.NetworkConfig
-	txInteractor            TxInteractor
-	txNonceHandler          TxNonceSenderHandler
-	dataFormatter           DataFormatter
-	scHeaderVerifierAddress string
-	scDcdtSafeAddress       string
+	wallet         core.CryptoComponentsHolder
+	netConfigs     *data.NetworkConfig
+	txInteractor   TxInteractor
+	txNonceHandler TxNonceSenderHandler
+	dataFormatter  DataFormatter
+	metrics        Metrics
+	sendingConfig  atomic.Pointer[SendingConfig]
+	workers        *workerPool
+	pauseGate      *pauseGate
+	dispatchQueue  chan *txBatch
+	broadcastQueue chan *txBatch
+	wgLoops        sync.WaitGroup
+	ctx            context.Context
+	cancel         func()
+
+	pendingTxs   atomic.Int64
+	inFlight     atomic.Int64
+	shuttingDown atomic.Bool
+	mutLastTx    sync.RWMutex
+	lastSentTx   *SentTx
+}
+
+// SentTx holds the last tx sent to main chain
+type SentTx struct {
+	Hash      string    `json:"hash"`
+	Nonce     uint64    `json:"nonce"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Stats holds the tx sender state reported by the server status
+type Stats struct {
+	PendingTxs int     `json:"pendingTxs"`
+	LastSentTx *SentTx `json:"lastSentTx,omitempty"`
 }
 
 // NewTxSender creates a new tx sender
@@ -44,15 +83,29 @@ func NewTxSender(args TxSenderArgs) (*txSender, error) {
 		return nil, err
 	}
 
-	return &txSender{
-		wallet:                  args.Wallet,
-		netConfigs:              networkConfig,
-		txInteractor:            args.TxInteractor,
-		txNonceHandler:          args.TxNonceHandler,
-		dataFormatter:           args.DataFormatter,
-		scHeaderVerifierAddress: args.SCHeaderVerifierAddress,
-		scDcdtSafeAddress:       args.SCDcdtSafeAddress,
-	}, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	ts := &txSender{
+		wallet:         args.Wallet,
+		netConfigs:     networkConfig,
+		txInteractor:   args.TxInteractor,
+		txNonceHandler: args.TxNonceHandler,
+		dataFormatter:  args.DataFormatter,
+		metrics:        args.Metrics,
+		workers:        newWorkerPool(args.NumWorkers),
+		pauseGate:      newPauseGate(),
+		dispatchQueue:  make(chan *txBatch, dispatchQueueSize),
+		broadcastQueue: make(chan *txBatch, args.NumWorkers),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+	sendingConfig := createSendingConfig(args)
+	ts.sendingConfig.Store(&sendingConfig)
+
+	ts.wgLoops.Add(2)
+	go ts.dispatchBatches()
+	go ts.broadcastBatches()
+
+	return ts, nil
 }
 
 func checkArgs(args TxSenderArgs) error {
@@ -71,80 +124,228 @@ func checkArgs(args TxSenderArgs) error {
 	if check.IfNil(args.TxNonceHandler) {
 		return errNilNonceHandler
 	}
-	if len(args.SCHeaderVerifierAddress) == 0 {
-		return errNoHeaderVerifierSCAddress
+	if check.IfNil(args.Metrics) {
+		return errNilMetrics
 	}
-	if len(args.SCDcdtSafeAddress) == 0 {
-		return errNoDcdtSafeSCAddress
+	if args.NumWorkers < 1 {
+		return fmt.Errorf("%w: %d", errInvalidNumWorkers, args.NumWorkers)
 	}
 
-	return nil
+	return createSendingConfig(args).Check()
 }
 
-// SendTxs should send bridge data operation txs
+func createSendingConfig(args TxSenderArgs) SendingConfig {
+	return SendingConfig{
+		HeaderVerifierSCAddress: args.SCHeaderVerifierAddress,
+		DcdtSafeSCAddress:       args.SCDcdtSafeAddress,
+		RegisterGas:             ApplyGasDefaults(args.RegisterGas),
+		ExecuteGas:              ApplyGasDefaults(args.ExecuteGas),
+	}
+}
+
+// SendTxs should send bridge data operation txs. All txs created from the provided data are assigned consecutive nonces
+// and are broadcast in order, after any previously received bridge data.
 func (ts *txSender) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
-	if len(data.Data) == 0 {
+	if len(data.GetData()) == 0 {
 		return make([]string, 0), nil
 	}
 
-	return ts.createAndSendTxs(ctx, data)
+	// counted before checking the shutdown, so that a shutdown waits for all accepted requests
+	ts.inFlight.Add(1)
+	defer ts.inFlight.Add(-1)
+	if ts.shuttingDown.Load() {
+		return nil, classifyError(errTxSenderClosed)
+	}
+
+	hashes, err := ts.createAndSendTxs(ctx, data)
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	return hashes, nil
+}
+
+// classifyError maps the error to the bridge errors taxonomy, so that clients receive the matching grpc status code
+func classifyError(err error) error {
+	if errors.Is(err, errTxSenderClosed) {
+		return &bridgeErrors.UnavailableError{Err: err}
+	}
+
+	return bridgeErrors.Classify(err)
 }
 
 func (ts *txSender) createAndSendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
-	txHashes := make([]string, 0)
+	txs, err := ts.createTxs(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return make([]string, 0), nil
+	}
+
+	batch := newTxBatch(ctx, txs)
+	ts.pendingTxs.Add(int64(len(txs)))
+	select {
+	case ts.dispatchQueue <- batch:
+		ts.metrics.SetQueueDepth(len(ts.dispatchQueue))
+	case <-ctx.Done():
+		ts.pendingTxs.Add(-int64(len(txs)))
+		return nil, ctx.Err()
+	case <-ts.ctx.Done():
+		ts.pendingTxs.Add(-int64(len(txs)))
+		return nil, errTxSenderClosed
+	}
+
+	select {
+	case res := <-batch.result:
+		return res.hashes, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-ts.ctx.Done():
+		return nil, errTxSenderClosed
+	}
+}
+
+func (ts *txSender) createTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]*coreTx.FrontendTransaction, error) {
+	var txs []*coreTx.FrontendTransaction
+	formatted := make(chan struct{})
+	wasScheduled := ts.workers.submit(ctx, func() {
+		txs = ts.formatTxs(data)
+		close(formatted)
+	})
+	if !wasScheduled {
+		return nil, ts.interruptionErr(ctx)
+	}
+
+	select {
+	case <-formatted:
+		return txs, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-ts.ctx.Done():
+		return nil, errTxSenderClosed
+	}
+}
+
+// formatTxs creates the txs without receiver and gas, which are set from the sending config when nonces are assigned
+func (ts *txSender) formatTxs(data *sovereign.BridgeOperations) []*coreTx.FrontendTransaction {
 	txsData := ts.dataFormatter.CreateTxsData(data)
+	txs := make([]*coreTx.FrontendTransaction, 0, len(txsData))
 
 	for _, txData := range txsData {
-		var tx *coreTx.FrontendTransaction
-
-		switch {
-		case strings.HasPrefix(string(txData), registerBridgeOpsPrefix):
-			tx = &coreTx.FrontendTransaction{
-				Value:    "0",
-				Receiver: ts.scHeaderVerifierAddress,
-				Sender:   ts.wallet.GetBech32(),
-				GasPrice: ts.netConfigs.MinGasPrice,
-				GasLimit: 50_000_000, // todo
-				Data:     txData,
-				ChainID:  ts.netConfigs.ChainID,
-				Version:  ts.netConfigs.MinTransactionVersion,
-			}
-		case strings.HasPrefix(string(txData), executeBridgeOpsPrefix):
-			tx = &coreTx.FrontendTransaction{
-				Value:    "0",
-				Receiver: ts.scDcdtSafeAddress,
-				Sender:   ts.wallet.GetBech32(),
-				GasPrice: ts.netConfigs.MinGasPrice,
-				GasLimit: 50_000_000, // todo
-				Data:     txData,
-				ChainID:  ts.netConfigs.ChainID,
-				Version:  ts.netConfigs.MinTransactionVersion,
-			}
-		default:
+		if !strings.HasPrefix(string(txData), registerBridgeOpsPrefix) && !strings.HasPrefix(string(txData), executeBridgeOpsPrefix) {
 			log.Error("invalid tx data received", "data", string(txData))
 			continue
 		}
 
-		err := ts.txNonceHandler.ApplyNonceAndGasPrice(ctx, tx)
-		if err != nil {
-			return nil, err
-		}
+		txs = append(txs, &coreTx.FrontendTransaction{
+			Value:   "0",
+			Sender:  ts.wallet.GetBech32(),
+			Data:    txData,
+			ChainID: ts.netConfigs.ChainID,
+			Version: ts.netConfigs.MinTransactionVersion,
+		})
+	}
 
-		err = ts.txInteractor.ApplyUserSignature(ts.wallet, tx)
-		if err != nil {
-			return nil, err
-		}
+	return txs
+}
+
+func (ts *txSender) interruptionErr(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return errTxSenderClosed
+}
+
+// Stats returns the number of txs waiting to be sent and the last sent tx
+func (ts *txSender) Stats() Stats {
+	ts.mutLastTx.RLock()
+	defer ts.mutLastTx.RUnlock()
+
+	stats := Stats{
+		PendingTxs: int(ts.pendingTxs.Load()),
+	}
+	if ts.lastSentTx != nil {
+		lastSentTx := *ts.lastSentTx
+		stats.LastSentTx = &lastSentTx
+	}
+
+	return stats
+}
+
+// SetPaused pauses, or resumes, assigning nonces and sending txs. While paused, received bridge data is queued and
+// batches which already have their nonces assigned are still sent.
+func (ts *txSender) SetPaused(paused bool) {
+	if ts.pauseGate.setPaused(paused) {
+		log.Info("tx sender paused state changed", "paused", paused)
+	}
+}
 
-		hash, err := ts.txNonceHandler.SendTransactions(ctx, tx)
-		if err != nil {
-			log.Error("failed to send tx", "error", err, "nonce", tx.Nonce)
-			return nil, err
+// SendingConfig returns the bridge contracts and gas settings of the sent txs
+func (ts *txSender) SendingConfig() SendingConfig {
+	return *ts.sendingConfig.Load()
+}
+
+// SetSendingConfig replaces the bridge contracts and gas settings. The new config is used starting with the next batch
+// which is assigned nonces, all txs of a batch being sent with the same config.
+func (ts *txSender) SetSendingConfig(cfg SendingConfig) error {
+	err := cfg.Check()
+	if err != nil {
+		return err
+	}
+
+	ts.sendingConfig.Store(&cfg)
+	log.Info("sending config changed", "config", fmt.Sprintf("%+v", cfg))
+
+	return nil
+}
+
+// Shutdown rejects new bridge data and waits until the accepted one is sent, including the batches whose callers are no
+// longer waiting for them, then closes the tx sender. While paused, queued batches are not sent, so they are rejected
+// once the context is done, along with all other batches not sent by then, and the context error is returned.
+func (ts *txSender) Shutdown(ctx context.Context) error {
+	ts.shuttingDown.Store(true)
+	log.Info("tx sender shutting down", "in flight requests", ts.inFlight.Load(), "pending txs", ts.pendingTxs.Load())
+
+	err := ts.waitUntilIdle(ctx)
+	if err != nil {
+		log.Warn("tx sender closed before sending all txs", "in flight requests", ts.inFlight.Load(),
+			"pending txs", ts.pendingTxs.Load(), "error", err)
+	}
+
+	closeErr := ts.Close()
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return err
+}
+
+func (ts *txSender) waitUntilIdle(ctx context.Context) error {
+	ticker := time.NewTicker(shutdownCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if ts.inFlight.Load() == 0 && ts.pendingTxs.Load() == 0 {
+			return nil
 		}
 
-		txHashes = append(txHashes, hash...)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
 	}
+}
 
-	return txHashes, nil
+// Close stops the dispatcher and the workers. Bridge data which is still waiting to be sent will be rejected.
+func (ts *txSender) Close() error {
+	ts.cancel()
+	ts.wgLoops.Wait()
+	ts.workers.close()
+
+	return nil
 }
 
 // IsInterfaceNil checks if the underlying pointer is nil
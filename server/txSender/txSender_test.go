@@ -2,9 +2,13 @@ package txSender
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"runtime"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
 
@@ -13,6 +17,8 @@ import (
 	"github.com/TerraDharitri/drt-go-sdk/core"
 	"github.com/TerraDharitri/drt-go-sdk/data"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -27,8 +33,10 @@ func createArgs() TxSenderArgs {
 		TxInteractor:            &testscommon.TxInteractorMock{},
 		DataFormatter:           &testscommon.DataFormatterMock{},
 		TxNonceHandler:          &testscommon.TxNonceSenderHandlerMock{},
+		Metrics:                 &testscommon.TxSenderMetricsMock{},
 		SCHeaderVerifierAddress: scHeaderVerifierAddress,
 		SCDcdtSafeAddress:       scDcdtSafeAddress,
+		NumWorkers:              4,
 	}
 }
 
@@ -67,12 +75,43 @@ func TestNewTxSender(t *testing.T) {
 		require.Nil(t, ts)
 		require.Equal(t, errNilDataFormatter, err)
 	})
+	t.Run("nil metrics", func(t *testing.T) {
+		args := createArgs()
+		args.Metrics = nil
+
+		ts, err := NewTxSender(args)
+		require.Nil(t, ts)
+		require.Equal(t, errNilMetrics, err)
+	})
+	t.Run("invalid number of workers", func(t *testing.T) {
+		args := createArgs()
+		args.NumWorkers = 0
+
+		ts, err := NewTxSender(args)
+		require.Nil(t, ts)
+		require.ErrorIs(t, err, errInvalidNumWorkers)
+	})
+	t.Run("invalid gas config", func(t *testing.T) {
+		args := createArgs()
+		args.ExecuteGas.GasPriceMultiplier = 0.5
+
+		ts, err := NewTxSender(args)
+		require.Nil(t, ts)
+		require.ErrorIs(t, err, errInvalidGasPriceMultiplier)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createArgs()
 
 		ts, err := NewTxSender(args)
 		require.Nil(t, err)
 		require.False(t, ts.IsInterfaceNil())
+		require.Equal(t, SendingConfig{
+			HeaderVerifierSCAddress: scHeaderVerifierAddress,
+			DcdtSafeSCAddress:       scDcdtSafeAddress,
+			RegisterGas:             GasConfig{GasLimit: defaultGasLimit, GasPriceMultiplier: defaultGasPriceMultiplier},
+			ExecuteGas:              GasConfig{GasLimit: defaultGasLimit, GasPriceMultiplier: defaultGasPriceMultiplier},
+		}, ts.SendingConfig())
+		require.Nil(t, ts.Close())
 	})
 }
 
@@ -80,8 +119,6 @@ func TestTxSender_SendTxs(t *testing.T) {
 	t.Parallel()
 
 	expectedCtx := context.Background()
-	expectedNonce := 0
-	expectedDataIdx := 0
 	expectedTxHashes := []string{"txHash1", "txHash2", "txHash3"}
 	expectedTxsData := [][]byte{
 		[]byte(registerBridgeOpsPrefix + "txData1"),
@@ -93,7 +130,11 @@ func TestTxSender_SendTxs(t *testing.T) {
 		scDcdtSafeAddress,
 		scDcdtSafeAddress,
 	}
-	expectedSigs := []string{"sig1", "sig2", "sig3"}
+	expectedSigs := map[string]string{
+		string(expectedTxsData[0]): "sig1",
+		string(expectedTxsData[1]): "sig2",
+		string(expectedTxsData[2]): "sig3",
+	}
 	expectedBridgeData := &sovereign.BridgeOperations{
 		Data: []*sovereign.BridgeOutGoingData{
 			{
@@ -107,6 +148,8 @@ func TestTxSender_SendTxs(t *testing.T) {
 		MinTransactionVersion: 2,
 	}
 
+	numApplyNonceCalls := 0
+	numSendCalls := 0
 	args := createArgs()
 	args.Proxy = &testscommon.ProxyMock{
 		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
@@ -122,59 +165,419 @@ func TestTxSender_SendTxs(t *testing.T) {
 	}
 	args.TxInteractor = &testscommon.TxInteractorMock{
 		ApplyUserSignatureCalled: func(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
-			tx.Signature = expectedSigs[expectedDataIdx]
+			tx.Signature = expectedSigs[string(tx.Data)]
 			return nil
 		},
 	}
 	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
 		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
-			require.Len(t, txs, 1) // we update transactions one at a time
-			require.Equal(t, &transaction.FrontendTransaction{
-				Nonce:    0,
-				Value:    "0",
-				Receiver: expectedTxsReceiver[expectedDataIdx],
-				Sender:   args.Wallet.GetBech32(),
-				GasPrice: expectedNetworkConfig.MinGasPrice,
-				GasLimit: 50_000_000,
-				Data:     expectedTxsData[expectedDataIdx],
-				ChainID:  expectedNetworkConfig.ChainID,
-				Version:  expectedNetworkConfig.MinTransactionVersion,
-			}, txs[0])
-
-			expectedNonce++
-			txs[0].Nonce = uint64(expectedNonce)
+			numApplyNonceCalls++
+
+			require.Equal(t, expectedCtx, ctx)
+			require.Len(t, txs, 3) // all txs from one bridge data are updated at once
+			for idx, tx := range txs {
+				require.Equal(t, &transaction.FrontendTransaction{
+					Nonce:    0,
+					Value:    "0",
+					Receiver: expectedTxsReceiver[idx],
+					Sender:   args.Wallet.GetBech32(),
+					GasPrice: expectedNetworkConfig.MinGasPrice,
+					GasLimit: 50_000_000,
+					Data:     expectedTxsData[idx],
+					ChainID:  expectedNetworkConfig.ChainID,
+					Version:  expectedNetworkConfig.MinTransactionVersion,
+				}, tx)
+
+				tx.Nonce = uint64(idx + 1)
+			}
+
 			return nil
 		},
 		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
-			defer func() {
-				expectedDataIdx++
-			}()
+			numSendCalls++
 
-			require.Equal(t, expectedCtx, ctx)
-			require.Len(t, txs, 1) // we send transactions one at a time
-			require.Equal(t, &transaction.FrontendTransaction{
-				Nonce:     uint64(expectedNonce),
-				Value:     "0",
-				Receiver:  expectedTxsReceiver[expectedDataIdx],
-				Sender:    args.Wallet.GetBech32(),
-				GasPrice:  expectedNetworkConfig.MinGasPrice,
-				GasLimit:  50_000_000,
-				Data:      expectedTxsData[expectedDataIdx],
-				Signature: expectedSigs[expectedDataIdx],
-				ChainID:   expectedNetworkConfig.ChainID,
-				Version:   expectedNetworkConfig.MinTransactionVersion,
-			}, txs[0])
+			require.Len(t, txs, 3) // all txs from one bridge data are sent at once
+			for idx, tx := range txs {
+				require.Equal(t, &transaction.FrontendTransaction{
+					Nonce:     uint64(idx + 1),
+					Value:     "0",
+					Receiver:  expectedTxsReceiver[idx],
+					Sender:    args.Wallet.GetBech32(),
+					GasPrice:  expectedNetworkConfig.MinGasPrice,
+					GasLimit:  50_000_000,
+					Data:      expectedTxsData[idx],
+					Signature: expectedSigs[string(expectedTxsData[idx])],
+					ChainID:   expectedNetworkConfig.ChainID,
+					Version:   expectedNetworkConfig.MinTransactionVersion,
+				}, tx)
+			}
 
-			return []string{expectedTxHashes[expectedDataIdx]}, nil
+			return expectedTxHashes, nil
 		},
 	}
 
 	ts, _ := NewTxSender(args)
+	defer func() {
+		require.Nil(t, ts.Close())
+	}()
+
 	txHashes, err := ts.SendTxs(expectedCtx, expectedBridgeData)
 	require.Nil(t, err)
 	require.Equal(t, expectedTxHashes, txHashes)
-	require.Equal(t, 3, expectedNonce)
-	require.Equal(t, 3, expectedDataIdx)
+	require.Equal(t, 1, numApplyNonceCalls)
+	require.Equal(t, 1, numSendCalls)
+}
+
+func TestTxSender_SendTxsErrors(t *testing.T) {
+	t.Parallel()
+
+	bridgeData := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("bridgeDataHash"),
+			},
+		},
+	}
+	dataFormatter := &testscommon.DataFormatterMock{
+		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+			return [][]byte{[]byte(executeBridgeOpsPrefix + "txData")}
+		},
+	}
+
+	t.Run("nil bridge operations should not send", func(t *testing.T) {
+		args := createArgs()
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				require.Fail(t, "should not apply nonce")
+				return nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			require.Nil(t, ts.Close())
+		}()
+
+		txHashes, err := ts.SendTxs(context.Background(), nil)
+		require.Nil(t, err)
+		require.Empty(t, txHashes)
+	})
+	t.Run("apply nonce error should not send", func(t *testing.T) {
+		expectedErr := errors.New("nonce error")
+		args := createArgs()
+		args.DataFormatter = dataFormatter
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				return expectedErr
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				require.Fail(t, "should not send txs")
+				return nil, nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			require.Nil(t, ts.Close())
+		}()
+
+		txHashes, err := ts.SendTxs(context.Background(), bridgeData)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, txHashes)
+	})
+	t.Run("signing error should not send", func(t *testing.T) {
+		expectedErr := errors.New("signing error")
+		args := createArgs()
+		args.DataFormatter = dataFormatter
+		args.TxInteractor = &testscommon.TxInteractorMock{
+			ApplyUserSignatureCalled: func(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+				return expectedErr
+			},
+		}
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				require.Fail(t, "should not send txs")
+				return nil, nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			require.Nil(t, ts.Close())
+		}()
+
+		txHashes, err := ts.SendTxs(context.Background(), bridgeData)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, txHashes)
+	})
+	t.Run("cancelled context should not consume nonces", func(t *testing.T) {
+		args := createArgs()
+		args.DataFormatter = dataFormatter
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				require.Fail(t, "should not apply nonce")
+				return nil
+			},
+		}
+
+		ts, _ := NewTxSender(args)
+		defer func() {
+			require.Nil(t, ts.Close())
+		}()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		txHashes, err := ts.SendTxs(ctx, bridgeData)
+		require.Equal(t, context.Canceled, err)
+		require.Nil(t, txHashes)
+	})
+	t.Run("closed sender", func(t *testing.T) {
+		args := createArgs()
+		args.DataFormatter = dataFormatter
+
+		ts, _ := NewTxSender(args)
+		require.Nil(t, ts.Close())
+
+		txHashes, err := ts.SendTxs(context.Background(), bridgeData)
+		require.ErrorIs(t, err, errTxSenderClosed)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Nil(t, txHashes)
+	})
+}
+
+func TestTxSender_SetPaused(t *testing.T) {
+	t.Parallel()
+
+	bridgeData := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("bridgeDataHash"),
+			},
+		},
+	}
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+			return [][]byte{[]byte(executeBridgeOpsPrefix + "txData")}
+		},
+	}
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			return []string{"txHash"}, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	defer func() {
+		require.Nil(t, ts.Close())
+	}()
+
+	ts.SetPaused(true)
+	ts.SetPaused(true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	txHashes, err := ts.SendTxs(ctx, bridgeData)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Nil(t, txHashes)
+
+	type sendResult struct {
+		hashes []string
+		err    error
+	}
+	results := make(chan sendResult)
+	go func() {
+		hashes, errSend := ts.SendTxs(context.Background(), bridgeData)
+		results <- sendResult{hashes: hashes, err: errSend}
+	}()
+
+	select {
+	case <-results:
+		require.Fail(t, "should not send while paused")
+	case <-time.After(time.Millisecond * 100):
+	}
+	// the expired batch is held as well, it is only rejected once resumed, without consuming a nonce
+	require.Equal(t, 2, ts.Stats().PendingTxs)
+
+	ts.SetPaused(false)
+	res := <-results
+	require.Nil(t, res.err)
+	require.Equal(t, []string{"txHash"}, res.hashes)
+	require.Eventually(t, func() bool {
+		return ts.Stats().PendingTxs == 0
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestTxSender_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	bridgeData := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("bridgeDataHash"),
+			},
+		},
+	}
+	type sendResult struct {
+		hashes []string
+		err    error
+	}
+
+	t.Run("should send the in flight batches and reject new ones", func(t *testing.T) {
+		sending := make(chan struct{})
+		release := make(chan struct{})
+		args := createArgs()
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+				return [][]byte{[]byte(executeBridgeOpsPrefix + "txData")}
+			},
+		}
+		args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				close(sending)
+				<-release
+				return []string{"txHash"}, nil
+			},
+		}
+		ts, _ := NewTxSender(args)
+
+		results := make(chan sendResult, 1)
+		go func() {
+			hashes, errSend := ts.SendTxs(context.Background(), bridgeData)
+			results <- sendResult{hashes: hashes, err: errSend}
+		}()
+		<-sending
+
+		shutdownErr := make(chan error, 1)
+		go func() {
+			shutdownErr <- ts.Shutdown(context.Background())
+		}()
+		require.Eventually(t, ts.shuttingDown.Load, time.Second, time.Millisecond*10)
+
+		txHashes, err := ts.SendTxs(context.Background(), bridgeData)
+		require.ErrorIs(t, err, errTxSenderClosed)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Nil(t, txHashes)
+
+		select {
+		case <-shutdownErr:
+			require.Fail(t, "should wait for the in flight batch")
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		close(release)
+		res := <-results
+		require.Nil(t, res.err)
+		require.Equal(t, []string{"txHash"}, res.hashes)
+		require.Nil(t, <-shutdownErr)
+	})
+	t.Run("batches not sent until the timeout should be rejected", func(t *testing.T) {
+		args := createArgs()
+		args.DataFormatter = &testscommon.DataFormatterMock{
+			CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+				return [][]byte{[]byte(executeBridgeOpsPrefix + "txData")}
+			},
+		}
+		ts, _ := NewTxSender(args)
+		ts.SetPaused(true)
+
+		results := make(chan sendResult, 1)
+		go func() {
+			hashes, errSend := ts.SendTxs(context.Background(), bridgeData)
+			results <- sendResult{hashes: hashes, err: errSend}
+		}()
+		require.Eventually(t, func() bool {
+			return ts.Stats().PendingTxs == 1
+		}, time.Second, time.Millisecond*10)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+		err := ts.Shutdown(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+
+		res := <-results
+		require.ErrorIs(t, res.err, errTxSenderClosed)
+		require.Nil(t, res.hashes)
+	})
+}
+
+func TestTxSender_SetSendingConfig(t *testing.T) {
+	t.Parallel()
+
+	bridgeData := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("bridgeDataHash"),
+			},
+		},
+	}
+
+	sentTxs := make([]*transaction.FrontendTransaction, 0)
+	args := createArgs()
+	args.Proxy = &testscommon.ProxyMock{
+		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{MinGasPrice: 1000}, nil
+		},
+	}
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+			return [][]byte{
+				[]byte(registerBridgeOpsPrefix + "txData1"),
+				[]byte(executeBridgeOpsPrefix + "txData2"),
+			}
+		},
+	}
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			sentTxs = append(sentTxs, txs...)
+			return []string{"txHash1", "txHash2"}, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	defer func() {
+		require.Nil(t, ts.Close())
+	}()
+
+	invalidConfig := ts.SendingConfig()
+	invalidConfig.RegisterGas.GasLimit = 0
+	err := ts.SetSendingConfig(invalidConfig)
+	require.ErrorIs(t, err, errInvalidGasLimit)
+
+	invalidConfig = ts.SendingConfig()
+	invalidConfig.DcdtSafeSCAddress = ""
+	err = ts.SetSendingConfig(invalidConfig)
+	require.Equal(t, errNoDcdtSafeSCAddress, err)
+
+	_, err = ts.SendTxs(context.Background(), bridgeData)
+	require.Nil(t, err)
+
+	newConfig := SendingConfig{
+		HeaderVerifierSCAddress: "drt1newHeaderVerifier",
+		DcdtSafeSCAddress:       "drt1newDcdtSafe",
+		RegisterGas:             GasConfig{GasLimit: 10_000_000, GasPriceMultiplier: 1.5},
+		ExecuteGas:              GasConfig{GasLimit: 20_000_000, GasPriceMultiplier: 2},
+	}
+	require.Nil(t, ts.SetSendingConfig(newConfig))
+	require.Equal(t, newConfig, ts.SendingConfig())
+
+	_, err = ts.SendTxs(context.Background(), bridgeData)
+	require.Nil(t, err)
+
+	require.Len(t, sentTxs, 4)
+	require.Equal(t, scHeaderVerifierAddress, sentTxs[0].Receiver)
+	require.Equal(t, uint64(defaultGasLimit), sentTxs[0].GasLimit)
+	require.Equal(t, uint64(1000), sentTxs[0].GasPrice)
+	require.Equal(t, scDcdtSafeAddress, sentTxs[1].Receiver)
+
+	require.Equal(t, "drt1newHeaderVerifier", sentTxs[2].Receiver)
+	require.Equal(t, uint64(10_000_000), sentTxs[2].GasLimit)
+	require.Equal(t, uint64(1500), sentTxs[2].GasPrice)
+	require.Equal(t, "drt1newDcdtSafe", sentTxs[3].Receiver)
+	require.Equal(t, uint64(20_000_000), sentTxs[3].GasLimit)
+	require.Equal(t, uint64(2000), sentTxs[3].GasPrice)
 }
 
 func TestTxSender_SendTxsConcurrently(t *testing.T) {
@@ -209,6 +612,9 @@ func TestTxSender_SendTxsConcurrently(t *testing.T) {
 	}
 
 	ts, _ := NewTxSender(args)
+	defer func() {
+		require.Nil(t, ts.Close())
+	}()
 
 	for i := 0; i < numTxsToSend; i++ {
 		go func(idx int) {
@@ -227,3 +633,191 @@ func TestTxSender_SendTxsConcurrently(t *testing.T) {
 	wg.Wait()
 	require.Equal(t, numTxsToSend, numSentTxs)
 }
+
+func TestTxSender_SendTxsConcurrentlyShouldKeepConsecutiveNonces(t *testing.T) {
+	t.Parallel()
+
+	numBridgeData := 100
+	numTxsPerBridgeData := 5
+
+	args := createArgs()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+			txsData := make([][]byte, 0, numTxsPerBridgeData)
+			for i := 0; i < numTxsPerBridgeData; i++ {
+				txsData = append(txsData, []byte(fmt.Sprintf("%s@%s@%d", executeBridgeOpsPrefix, data.Data[0].Hash, i)))
+			}
+
+			return txsData
+		},
+	}
+
+	nonce := uint64(0)
+	broadcastNonces := make([]uint64, 0, numBridgeData*numTxsPerBridgeData)
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+			for _, tx := range txs {
+				tx.Nonce = nonce
+				nonce++
+			}
+			return nil
+		},
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				broadcastNonces = append(broadcastNonces, tx.Nonce)
+				hashes = append(hashes, fmt.Sprintf("%d", tx.Nonce))
+			}
+			return hashes, nil
+		},
+	}
+
+	ts, _ := NewTxSender(args)
+	defer func() {
+		require.Nil(t, ts.Close())
+	}()
+
+	wg := sync.WaitGroup{}
+	wg.Add(numBridgeData)
+	for i := 0; i < numBridgeData; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			txHashes, err := ts.SendTxs(context.Background(), &sovereign.BridgeOperations{
+				Data: []*sovereign.BridgeOutGoingData{
+					{
+						Hash: []byte(fmt.Sprintf("hash%d", idx)),
+					},
+				},
+			})
+			require.Nil(t, err)
+			require.Len(t, txHashes, numTxsPerBridgeData)
+
+			firstNonce, _ := strconv.Atoi(txHashes[0])
+			for txIdx, txHash := range txHashes {
+				require.Equal(t, fmt.Sprintf("%d", firstNonce+txIdx), txHash)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	require.Len(t, broadcastNonces, numBridgeData*numTxsPerBridgeData)
+	for idx, broadcastNonce := range broadcastNonces {
+		require.Equal(t, uint64(idx), broadcastNonce)
+	}
+}
+
+const (
+	benchSigningDuration   = 50 * time.Microsecond
+	benchBroadcastDuration = 500 * time.Microsecond
+)
+
+func createBenchArgs() TxSenderArgs {
+	args := createArgs()
+	args.NumWorkers = runtime.NumCPU()
+	args.DataFormatter = &testscommon.DataFormatterMock{
+		CreateTxsDataCalled: func(data *sovereign.BridgeOperations) [][]byte {
+			return [][]byte{
+				[]byte(registerBridgeOpsPrefix + "txData1"),
+				[]byte(executeBridgeOpsPrefix + "txData2"),
+				[]byte(executeBridgeOpsPrefix + "txData3"),
+			}
+		},
+	}
+	args.TxInteractor = &testscommon.TxInteractorMock{
+		ApplyUserSignatureCalled: func(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+			time.Sleep(benchSigningDuration)
+			return nil
+		},
+	}
+
+	mutNonce := sync.Mutex{}
+	nonce := uint64(0)
+	mutBroadcast := sync.Mutex{}
+	args.TxNonceHandler = &testscommon.TxNonceSenderHandlerMock{
+		ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+			mutNonce.Lock()
+			defer mutNonce.Unlock()
+
+			for _, tx := range txs {
+				tx.Nonce = nonce
+				nonce++
+			}
+			return nil
+		},
+		// one round trip to the proxy per call, serialized per wallet
+		SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			mutBroadcast.Lock()
+			defer mutBroadcast.Unlock()
+
+			time.Sleep(benchBroadcastDuration)
+			return make([]string, len(txs)), nil
+		},
+	}
+
+	return args
+}
+
+// sendTxsOneByOne is the previous tx sender flow, where each tx is assigned a nonce, signed and sent on its own
+func sendTxsOneByOne(ts *txSender, ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+	txHashes := make([]string, 0)
+	for _, tx := range ts.formatTxs(data) {
+		err := ts.txNonceHandler.ApplyNonceAndGasPrice(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ts.txInteractor.ApplyUserSignature(ts.wallet, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes, err := ts.txNonceHandler.SendTransactions(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txHashes = append(txHashes, hashes...)
+	}
+
+	return txHashes, nil
+}
+
+func BenchmarkTxSender_SendTxs(b *testing.B) {
+	bridgeData := &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash: []byte("bridgeDataHash"),
+			},
+		},
+	}
+
+	b.Run("one by one", func(b *testing.B) {
+		ts, _ := NewTxSender(createBenchArgs())
+		defer func() {
+			_ = ts.Close()
+		}()
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, err := sendTxsOneByOne(ts, context.Background(), bridgeData)
+				require.Nil(b, err)
+			}
+		})
+	})
+	b.Run("dispatcher pipeline", func(b *testing.B) {
+		ts, _ := NewTxSender(createBenchArgs())
+		defer func() {
+			_ = ts.Close()
+		}()
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, err := ts.SendTxs(context.Background(), bridgeData)
+				require.Nil(b, err)
+			}
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package txSender
+
+import (
+	"context"
+	"sync"
+)
+
+type workerPool struct {
+	jobs   chan func()
+	ctx    context.Context
+	cancel func()
+	wg     sync.WaitGroup
+}
+
+// newWorkerPool creates a fixed size pool of goroutines used for cpu bound work, such as txs formatting and signing
+func newWorkerPool(numWorkers int) *workerPool {
+	ctx, cancel := context.WithCancel(context.Background())
+	wp := &workerPool{
+		jobs:   make(chan func(), numWorkers),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	wp.wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go wp.work()
+	}
+
+	return wp
+}
+
+func (wp *workerPool) work() {
+	defer wp.wg.Done()
+
+	for {
+		select {
+		case job := <-wp.jobs:
+			job()
+		case <-wp.ctx.Done():
+			return
+		}
+	}
+}
+
+// submit schedules the job on one of the workers. It blocks while all workers are busy and returns false if the
+// pool was closed or the provided context is done before the job could be scheduled.
+func (wp *workerPool) submit(ctx context.Context, job func()) bool {
+	select {
+	case wp.jobs <- job:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-wp.ctx.Done():
+		return false
+	}
+}
+
+// close stops all workers and waits for the running jobs to finish
+func (wp *workerPool) close() {
+	wp.cancel()
+	wp.wg.Wait()
+}
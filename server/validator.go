@@ -0,0 +1,149 @@
+package server
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+)
+
+const (
+	defaultMaxBridgeData         = 100
+	defaultMaxOperations         = 1000
+	defaultMaxPayloadSizeInBytes = 2 * 1024 * 1024
+)
+
+func applyValidatorDefaults(cfg config.ValidatorConfig) config.ValidatorConfig {
+	if cfg.MaxBridgeData <= 0 {
+		cfg.MaxBridgeData = defaultMaxBridgeData
+	}
+	if cfg.MaxOperations <= 0 {
+		cfg.MaxOperations = defaultMaxOperations
+	}
+	if cfg.MaxPayloadSizeInBytes <= 0 {
+		cfg.MaxPayloadSizeInBytes = defaultMaxPayloadSizeInBytes
+	}
+
+	return cfg
+}
+
+type bridgeOperationsValidator struct {
+	cfg      atomic.Pointer[config.ValidatorConfig]
+	hashSize int
+}
+
+// NewBridgeOperationsValidator creates a validator for received bridge operations. Hashes are expected to have the
+// size of the provided hasher, the same one used by sovereign nodes.
+func NewBridgeOperationsValidator(cfg config.ValidatorConfig, hasher hashing.Hasher) (*bridgeOperationsValidator, error) {
+	if check.IfNil(hasher) {
+		return nil, core.ErrNilHasher
+	}
+
+	v := &bridgeOperationsValidator{
+		hashSize: hasher.Size(),
+	}
+	v.SetConfig(cfg)
+
+	return v, nil
+}
+
+// SetConfig changes the limits of the bridge operations validated from now on. Zero values use the default limits.
+func (v *bridgeOperationsValidator) SetConfig(cfg config.ValidatorConfig) {
+	cfg = applyValidatorDefaults(cfg)
+	v.cfg.Store(&cfg)
+}
+
+// Validate checks the bridge operations against the configured limits. It returns a bridgeErrors.ValidationError for
+// the first invalid field found.
+func (v *bridgeOperationsValidator) Validate(data *sovereign.BridgeOperations) error {
+	if data == nil {
+		return newValidationError("data", "nil bridge operations")
+	}
+
+	cfg := v.cfg.Load()
+	if len(data.Data) > cfg.MaxBridgeData {
+		return newValidationError("data", "too many bridge data: %d, max: %d", len(data.Data), cfg.MaxBridgeData)
+	}
+
+	numOperations := 0
+	payloadSize := 0
+	for i, bridgeData := range data.Data {
+		err := v.validateBridgeData(fmt.Sprintf("data[%d]", i), bridgeData)
+		if err != nil {
+			return err
+		}
+
+		numOperations += len(bridgeData.OutGoingOperations)
+		for _, operation := range bridgeData.OutGoingOperations {
+			payloadSize += len(operation.Data)
+		}
+	}
+
+	if numOperations > cfg.MaxOperations {
+		return newValidationError("data", "too many operations: %d, max: %d", numOperations, cfg.MaxOperations)
+	}
+	if payloadSize > cfg.MaxPayloadSizeInBytes {
+		return newValidationError("data", "payload too large: %d bytes, max: %d bytes", payloadSize, cfg.MaxPayloadSizeInBytes)
+	}
+
+	return nil
+}
+
+func (v *bridgeOperationsValidator) validateBridgeData(field string, bridgeData *sovereign.BridgeOutGoingData) error {
+	if bridgeData == nil {
+		return newValidationError(field, "nil bridge data")
+	}
+	if len(bridgeData.Hash) != v.hashSize {
+		return newValidationError(field+".hash", "invalid hash length: %d, expected: %d", len(bridgeData.Hash), v.hashSize)
+	}
+	if len(bridgeData.AggregatedSignature) == 0 {
+		return newValidationError(field+".aggregatedSignature", "empty signature")
+	}
+	if len(bridgeData.LeaderSignature) == 0 {
+		return newValidationError(field+".leaderSignature", "empty signature")
+	}
+	if len(bridgeData.OutGoingOperations) == 0 {
+		return newValidationError(field+".outGoingOperations", "no operations")
+	}
+
+	for i, operation := range bridgeData.OutGoingOperations {
+		err := v.validateOperation(fmt.Sprintf("%s.outGoingOperations[%d]", field, i), operation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *bridgeOperationsValidator) validateOperation(field string, operation *sovereign.OutGoingOperation) error {
+	if operation == nil {
+		return newValidationError(field, "nil operation")
+	}
+	if len(operation.Hash) != v.hashSize {
+		return newValidationError(field+".hash", "invalid hash length: %d, expected: %d", len(operation.Hash), v.hashSize)
+	}
+	if len(operation.Data) == 0 {
+		return newValidationError(field+".data", "empty data")
+	}
+
+	return nil
+}
+
+func newValidationError(field string, format string, args ...interface{}) error {
+	return &bridgeErrors.ValidationError{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	}
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (v *bridgeOperationsValidator) IsInterfaceNil() bool {
+	return v == nil
+}
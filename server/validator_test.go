@@ -0,0 +1,151 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/bridgeErrors"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+)
+
+func createValidBridgeOperations() *sovereign.BridgeOperations {
+	hash := []byte(strings.Repeat("h", 32))
+
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash:                hash,
+				AggregatedSignature: []byte("aggregatedSignature"),
+				LeaderSignature:     []byte("leaderSignature"),
+				OutGoingOperations: []*sovereign.OutGoingOperation{
+					{
+						Hash: hash,
+						Data: []byte("data"),
+					},
+					{
+						Hash: hash,
+						Data: []byte("data"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func requireValidationError(t *testing.T, err error, field string) {
+	validationErr := &bridgeErrors.ValidationError{}
+	require.ErrorAs(t, err, &validationErr)
+	require.Equal(t, field, validationErr.Field)
+}
+
+func TestNewBridgeOperationsValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hasher", func(t *testing.T) {
+		validator, err := NewBridgeOperationsValidator(config.ValidatorConfig{}, nil)
+		require.Equal(t, core.ErrNilHasher, err)
+		require.Nil(t, validator)
+	})
+	t.Run("should work with default limits", func(t *testing.T) {
+		validator, err := NewBridgeOperationsValidator(config.ValidatorConfig{}, sha256.NewSha256())
+		require.Nil(t, err)
+		require.False(t, validator.IsInterfaceNil())
+		require.Equal(t, config.ValidatorConfig{
+			MaxBridgeData:         defaultMaxBridgeData,
+			MaxOperations:         defaultMaxOperations,
+			MaxPayloadSizeInBytes: defaultMaxPayloadSizeInBytes,
+		}, *validator.cfg.Load())
+		require.Equal(t, 32, validator.hashSize)
+	})
+}
+
+func TestBridgeOperationsValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := NewBridgeOperationsValidator(config.ValidatorConfig{
+		MaxBridgeData:         2,
+		MaxOperations:         3,
+		MaxPayloadSizeInBytes: 10,
+	}, sha256.NewSha256())
+
+	t.Run("valid bridge operations", func(t *testing.T) {
+		require.Nil(t, validator.Validate(createValidBridgeOperations()))
+		require.Nil(t, validator.Validate(&sovereign.BridgeOperations{}))
+	})
+	t.Run("nil bridge operations", func(t *testing.T) {
+		requireValidationError(t, validator.Validate(nil), "data")
+	})
+	t.Run("too many bridge data", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data = append(data.Data, data.Data[0], data.Data[0])
+		requireValidationError(t, validator.Validate(data), "data")
+	})
+	t.Run("too many operations", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data = append(data.Data, data.Data[0])
+		requireValidationError(t, validator.Validate(data), "data")
+	})
+	t.Run("payload too large", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data[0].OutGoingOperations[1] = &sovereign.OutGoingOperation{
+			Hash: data.Data[0].Hash,
+			Data: []byte("large data"),
+		}
+		requireValidationError(t, validator.Validate(data), "data")
+	})
+	t.Run("nil bridge data", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data = append(data.Data, nil)
+		requireValidationError(t, validator.Validate(data), "data[1]")
+	})
+	t.Run("invalid bridge data hash", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data[0].Hash = []byte("hash")
+		requireValidationError(t, validator.Validate(data), "data[0].hash")
+	})
+	t.Run("empty signatures", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data[0].AggregatedSignature = nil
+		requireValidationError(t, validator.Validate(data), "data[0].aggregatedSignature")
+
+		data = createValidBridgeOperations()
+		data.Data[0].LeaderSignature = nil
+		requireValidationError(t, validator.Validate(data), "data[0].leaderSignature")
+	})
+	t.Run("no operations", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data[0].OutGoingOperations = nil
+		requireValidationError(t, validator.Validate(data), "data[0].outGoingOperations")
+	})
+	t.Run("invalid operations", func(t *testing.T) {
+		data := createValidBridgeOperations()
+		data.Data[0].OutGoingOperations[1] = nil
+		requireValidationError(t, validator.Validate(data), "data[0].outGoingOperations[1]")
+
+		data = createValidBridgeOperations()
+		data.Data[0].OutGoingOperations[1] = &sovereign.OutGoingOperation{Hash: []byte("hash"), Data: []byte("data")}
+		requireValidationError(t, validator.Validate(data), "data[0].outGoingOperations[1].hash")
+
+		data = createValidBridgeOperations()
+		data.Data[0].OutGoingOperations[0] = &sovereign.OutGoingOperation{Hash: data.Data[0].Hash}
+		requireValidationError(t, validator.Validate(data), "data[0].outGoingOperations[0].data")
+	})
+}
+
+func TestBridgeOperationsValidator_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := NewBridgeOperationsValidator(config.ValidatorConfig{}, sha256.NewSha256())
+	data := createValidBridgeOperations()
+	data.Data = append(data.Data, data.Data[0])
+	require.Nil(t, validator.Validate(data))
+
+	validator.SetConfig(config.ValidatorConfig{MaxBridgeData: 1})
+	requireValidationError(t, validator.Validate(data), "data")
+	require.Equal(t, defaultMaxOperations, validator.cfg.Load().MaxOperations)
+}
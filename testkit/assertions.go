@@ -0,0 +1,51 @@
+package testkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/stretchr/testify/require"
+)
+
+// RequireReceived fails the test unless the tx sender received exactly the expected bridge operations, in order
+func RequireReceived(t require.TestingT, txSender *FakeTxSender, expected ...*sovereign.BridgeOperations) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	require.Equal(t, expected, txSender.Received())
+}
+
+// RequireReceivedOperationHashes fails the test unless the tx sender received exactly the expected outgoing
+// operations hashes, in order, across all requests
+func RequireReceivedOperationHashes(t require.TestingT, txSender *FakeTxSender, expected ...[]byte) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	hashes := make([][]byte, 0)
+	for _, bridgeOps := range txSender.Received() {
+		for _, bridgeData := range bridgeOps.GetData() {
+			for _, operation := range bridgeData.GetOutGoingOperations() {
+				hashes = append(hashes, operation.Hash)
+			}
+		}
+	}
+
+	require.Equal(t, expected, hashes)
+}
+
+// RequireNumCalls waits until the tx sender received at least numCalls requests, failing the test after the
+// timeout. It is useful for clients which deliver operations asynchronously, such as the buffered client.
+func RequireNumCalls(t require.TestingT, txSender *FakeTxSender, numCalls int, timeout time.Duration) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := txSender.WaitForCalls(ctx, numCalls)
+	require.Nil(t, err, "expected %d calls, received %d", numCalls, txSender.NumCalls())
+}
@@ -0,0 +1,80 @@
+package testkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/metrics"
+)
+
+const (
+	bufConnSize = 1024 * 1024
+	sendTimeout = time.Minute
+)
+
+// BridgeServer is an in-process bridge server, served over an in-memory bufconn listener. It runs the same server
+// logic as the real bridge server, with the provided tx sender: the interceptors chain, idempotency keys handling and
+// validation of bridge operations with the default limits and sha256 hashes.
+type BridgeServer struct {
+	listener   *bufconn.Listener
+	grpcServer *grpc.Server
+}
+
+// NewBridgeServer starts an in-process bridge server which forwards all requests to the provided tx sender
+func NewBridgeServer(txSender server.TxSender) (*BridgeServer, error) {
+	if check.IfNil(txSender) {
+		return nil, errNilTxSender
+	}
+
+	validator, err := server.NewBridgeOperationsValidator(config.ValidatorConfig{}, sha256.NewSha256())
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeServer, err := server.NewSovereignBridgeTxServer(txSender, validator, sendTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeMetrics := metrics.NewPrometheusMetrics()
+	interceptors, err := server.NewUnaryInterceptors(bridgeMetrics, bridgeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := &BridgeServer{
+		listener:   bufconn.Listen(bufConnSize),
+		grpcServer: grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...)),
+	}
+	sovereign.RegisterBridgeTxSenderServer(bs.grpcServer, bridgeServer)
+
+	go func() {
+		_ = bs.grpcServer.Serve(bs.listener)
+	}()
+
+	return bs, nil
+}
+
+// NewClient creates a client connected to the server. Closing the client does not stop the server.
+func (bs *BridgeServer) NewClient(ctx context.Context) (client.ClientHandler, error) {
+	return client.NewBufConnClient(ctx, bs.listener)
+}
+
+// Listener returns the in-memory listener of the server, which can be used to dial it with custom options
+func (bs *BridgeServer) Listener() *bufconn.Listener {
+	return bs.listener
+}
+
+// Close stops the server, closing all connections
+func (bs *BridgeServer) Close() {
+	bs.grpcServer.Stop()
+}
@@ -0,0 +1,147 @@
+package testkit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/sha256"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var hasher = sha256.NewSha256()
+
+func opHash(name string) []byte {
+	return hasher.Compute(name)
+}
+
+func createBridgeOps(names ...string) *sovereign.BridgeOperations {
+	operations := make([]*sovereign.OutGoingOperation, 0, len(names))
+	for _, name := range names {
+		operations = append(operations, &sovereign.OutGoingOperation{
+			Hash: opHash(name),
+			Data: []byte("data"),
+		})
+	}
+
+	return &sovereign.BridgeOperations{
+		Data: []*sovereign.BridgeOutGoingData{
+			{
+				Hash:                opHash("hashOfHashes"),
+				AggregatedSignature: []byte("aggregatedSignature"),
+				LeaderSignature:     []byte("leaderSignature"),
+				OutGoingOperations:  operations,
+			},
+		},
+	}
+}
+
+func startServer(t *testing.T) (*FakeTxSender, *BridgeServer) {
+	txSender := NewFakeTxSender()
+	bridgeServer, err := NewBridgeServer(txSender)
+	require.Nil(t, err)
+	t.Cleanup(bridgeServer.Close)
+
+	return txSender, bridgeServer
+}
+
+func TestNewBridgeServer(t *testing.T) {
+	t.Parallel()
+
+	bridgeServer, err := NewBridgeServer(nil)
+	require.Equal(t, errNilTxSender, err)
+	require.Nil(t, bridgeServer)
+}
+
+func TestBridgeServer_Send(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should record operations and return tx hashes", func(t *testing.T) {
+		txSender, bridgeServer := startServer(t)
+		c, err := bridgeServer.NewClient(context.Background())
+		require.Nil(t, err)
+		defer func() {
+			_ = c.Close()
+		}()
+
+		data1 := createBridgeOps("op1", "op2")
+		data2 := createBridgeOps("op3")
+
+		res, err := c.Send(context.Background(), data1)
+		require.Nil(t, err)
+		require.Len(t, res.TxHashes, 3)
+
+		res, err = c.Send(context.Background(), data2)
+		require.Nil(t, err)
+		require.Len(t, res.TxHashes, 2)
+
+		RequireNumCalls(t, txSender, 2, time.Second)
+		RequireReceived(t, txSender, data1, data2)
+		RequireReceivedOperationHashes(t, txSender, opHash("op1"), opHash("op2"), opHash("op3"))
+	})
+	t.Run("injected error should be returned", func(t *testing.T) {
+		txSender, bridgeServer := startServer(t)
+		c, _ := bridgeServer.NewClient(context.Background())
+		defer func() {
+			_ = c.Close()
+		}()
+
+		txSender.SetError(errors.New("local error"))
+		res, err := c.Send(context.Background(), createBridgeOps("op"))
+		require.ErrorContains(t, err, "local error")
+		require.Nil(t, res)
+
+		txSender.SetError(nil)
+		_, err = c.Send(context.Background(), createBridgeOps("op"))
+		require.Nil(t, err)
+	})
+	t.Run("transient failures should be retried by the client", func(t *testing.T) {
+		txSender, bridgeServer := startServer(t)
+		c, _ := bridgeServer.NewClient(context.Background())
+		defer func() {
+			_ = c.Close()
+		}()
+
+		txSender.FailNext(2, status.Error(codes.Unavailable, "unavailable"))
+		res, err := c.Send(context.Background(), createBridgeOps("op"))
+		require.Nil(t, err)
+		require.Len(t, res.TxHashes, 2)
+		require.Equal(t, 3, txSender.NumCalls())
+	})
+	t.Run("partial failures", func(t *testing.T) {
+		txSender, bridgeServer := startServer(t)
+		c, _ := bridgeServer.NewClient(context.Background())
+		defer func() {
+			_ = c.Close()
+		}()
+
+		txSender.FailEvery(2, nil)
+		numFailed := 0
+		for i := 0; i < 4; i++ {
+			_, err := c.Send(context.Background(), createBridgeOps("op", string(rune('a'+i))))
+			if err != nil {
+				require.ErrorContains(t, err, ErrInjected.Error())
+				numFailed++
+			}
+		}
+		require.Equal(t, 2, numFailed)
+	})
+	t.Run("latency should be bounded by the caller's deadline", func(t *testing.T) {
+		txSender, bridgeServer := startServer(t)
+		c, _ := bridgeServer.NewClient(context.Background())
+		defer func() {
+			_ = c.Close()
+		}()
+
+		txSender.SetLatency(time.Minute)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+
+		_, err := c.Send(ctx, createBridgeOps("op"))
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
@@ -0,0 +1,8 @@
+package testkit
+
+import "errors"
+
+// ErrInjected is the default error returned by the fake tx sender for injected failures
+var ErrInjected = errors.New("injected tx sender failure")
+
+var errNilTxSender = errors.New("nil tx sender provided")
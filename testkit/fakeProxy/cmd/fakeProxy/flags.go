@@ -0,0 +1,32 @@
+package main
+
+import (
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/urfave/cli"
+)
+
+var (
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,fork:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the fork package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogDebug.String(),
+	}
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "This flag specifies the `address` on which the fake proxy listens.",
+		Value: "127.0.0.1:8086",
+	}
+	chainID = cli.StringFlag{
+		Name:  "chain-id",
+		Usage: "This flag specifies the `chain ID` expected in all txs.",
+		Value: "local-testnet",
+	}
+	initialBalance = cli.StringFlag{
+		Name:  "initial-balance",
+		Usage: "This flag specifies the `balance`, in denominated units, of every account.",
+		Value: "1000000000000000000000000",
+	}
+)
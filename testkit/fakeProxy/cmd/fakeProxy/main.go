@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/urfave/cli"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testkit/fakeProxy"
+)
+
+var log = logger.GetOrCreate("fake-proxy")
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Fake Dharitri proxy"
+	app.Usage = "Local proxy with an in-memory ledger, used to run the bridge server end to end without a real chain. " +
+		"Set the server's DHARITRI_PROXY to the address of this proxy."
+	app.Action = startFakeProxy
+	app.Flags = []cli.Flag{
+		logLevel,
+		address,
+		chainID,
+		initialBalance,
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startFakeProxy(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	balance, ok := big.NewInt(0).SetString(ctx.GlobalString(initialBalance.Name), 10)
+	if !ok {
+		return fmt.Errorf("invalid initial balance: %s", ctx.GlobalString(initialBalance.Name))
+	}
+
+	listener, err := net.Listen("tcp", ctx.GlobalString(address.Name))
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{
+		Handler: fakeProxy.NewFakeProxy(fakeProxy.ArgsFakeProxy{
+			ChainID:        ctx.GlobalString(chainID.Name),
+			InitialBalance: balance,
+		}),
+	}
+
+	go func() {
+		log.Info("starting fake proxy", "address", listener.Addr().String(), "chain ID", ctx.GlobalString(chainID.Name))
+		errServe := httpServer.Serve(listener)
+		if errServe != nil && !errors.Is(errServe, http.ErrServerClosed) {
+			log.Error("fake proxy stopped", "error", errServe)
+		}
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+
+	<-interrupt
+	log.Info("closing fake proxy at user's signal")
+
+	return httpServer.Close()
+}
@@ -0,0 +1,35 @@
+package fakeProxy
+
+import "errors"
+
+var errInvalidChainID = errors.New("invalid chain ID")
+
+var errInvalidVersion = errors.New("invalid transaction version")
+
+var errGasPriceTooLow = errors.New("insufficient gas price")
+
+var errGasLimitTooLow = errors.New("insufficient gas limit")
+
+var errInvalidSignature = errors.New("invalid signature")
+
+var errInvalidValue = errors.New("invalid transaction value")
+
+var errNonceTooLow = errors.New("transaction nonce is lower than the account nonce")
+
+var errNonceTooHigh = errors.New("transaction nonce is too high")
+
+var errDuplicatedNonce = errors.New("transaction with the same nonce is already pending")
+
+var errDuplicatedTx = errors.New("transaction already received")
+
+var errInsufficientBalance = errors.New("insufficient balance")
+
+var errTxNotFound = errors.New("transaction not found")
+
+var errInvalidAddress = errors.New("invalid address")
+
+var errInvalidShardID = errors.New("invalid shard id")
+
+var errEndpointNotFound = errors.New("endpoint not found")
+
+var errInjectedSendFailure = errors.New("injected send failure")
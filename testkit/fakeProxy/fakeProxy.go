@@ -0,0 +1,435 @@
+package fakeProxy
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	chainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/keccak"
+	crypto "github.com/TerraDharitri/drt-go-chain-crypto"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing/ed25519"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+var log = logger.GetOrCreate("testkit/fakeProxy")
+
+const (
+	defaultChainID               = "local-testnet"
+	defaultMinGasPrice           = 1_000_000_000
+	defaultMinGasLimit           = 50_000
+	defaultGasPerDataByte        = 1_500
+	defaultMinTransactionVersion = 1
+	defaultMaxNonceGap           = 100
+
+	codeSuccessful    = "successful"
+	codeBadRequest    = "bad_request"
+	codeNotFound      = "not_found"
+	codeInternalIssue = "internal_issue"
+)
+
+// defaultInitialBalance is the balance of every account not set explicitly, 1_000_000 REWA
+var defaultInitialBalance, _ = big.NewInt(0).SetString("1000000000000000000000000", 10)
+
+// ArgsFakeProxy holds the network config of the fake proxy. Zero values are replaced with defaults.
+type ArgsFakeProxy struct {
+	ChainID               string
+	MinGasPrice           uint64
+	MinGasLimit           uint64
+	GasPerDataByte        uint64
+	MinTransactionVersion uint32
+	InitialBalance        *big.Int
+	MaxNonceGap           uint64
+}
+
+// FakeProxy is an http server implementing the proxy endpoints used by the bridge server: network config, network
+// status, account, send transaction(s) and transaction status. Received txs are validated as a node would, including
+// their signature and nonce, and are executed on an in-memory ledger. Every smart contract address is reported as a
+// deployed contract.
+type FakeProxy struct {
+	mutBehaviour   sync.RWMutex
+	latency        time.Duration
+	numFailSends   int
+	failSendErrMsg string
+
+	networkConfig *data.NetworkConfig
+	ledger        *ledger
+	keyGen        crypto.KeyGenerator
+	signer        signatureVerifier
+	txBuilder     txHashComputer
+}
+
+// NewFakeProxy creates a new fake proxy. It can be served with any http server, for example httptest.NewServer.
+func NewFakeProxy(args ArgsFakeProxy) *FakeProxy {
+	args = applyDefaults(args)
+	signer := cryptoProvider.NewSigner()
+	txBuilder, _ := builders.NewTxBuilder(signer)
+
+	return &FakeProxy{
+		networkConfig: &data.NetworkConfig{
+			ChainID:               args.ChainID,
+			MinGasPrice:           args.MinGasPrice,
+			MinGasLimit:           args.MinGasLimit,
+			GasPerDataByte:        args.GasPerDataByte,
+			MinTransactionVersion: args.MinTransactionVersion,
+			NumShardsWithoutMeta:  1,
+		},
+		ledger:    newLedger(args.InitialBalance, args.MaxNonceGap),
+		keyGen:    signing.NewKeyGenerator(ed25519.NewEd25519()),
+		signer:    signer,
+		txBuilder: txBuilder,
+	}
+}
+
+func applyDefaults(args ArgsFakeProxy) ArgsFakeProxy {
+	if len(args.ChainID) == 0 {
+		args.ChainID = defaultChainID
+	}
+	if args.MinGasPrice == 0 {
+		args.MinGasPrice = defaultMinGasPrice
+	}
+	if args.MinGasLimit == 0 {
+		args.MinGasLimit = defaultMinGasLimit
+	}
+	if args.GasPerDataByte == 0 {
+		args.GasPerDataByte = defaultGasPerDataByte
+	}
+	if args.MinTransactionVersion == 0 {
+		args.MinTransactionVersion = defaultMinTransactionVersion
+	}
+	if args.InitialBalance == nil {
+		args.InitialBalance = defaultInitialBalance
+	}
+	if args.MaxNonceGap == 0 {
+		args.MaxNonceGap = defaultMaxNonceGap
+	}
+
+	return args
+}
+
+// ServeHTTP routes the request to the proxy endpoint handler
+func (fp *FakeProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	isSendRequest := r.Method == http.MethodPost && len(path) == 2 && path[0] == "transaction"
+
+	if !fp.delay(r) {
+		writeResponse(w, http.StatusServiceUnavailable, codeInternalIssue, nil, r.Context().Err())
+		return
+	}
+	if isSendRequest {
+		err := fp.injectedSendError()
+		if err != nil {
+			writeResponse(w, http.StatusInternalServerError, codeInternalIssue, nil, err)
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && len(path) == 2 && path[0] == "network" && path[1] == "config":
+		fp.handleNetworkConfig(w)
+	case r.Method == http.MethodGet && len(path) == 3 && path[0] == "network" && path[1] == "status":
+		fp.handleNetworkStatus(w, path[2])
+	case r.Method == http.MethodGet && len(path) == 2 && path[0] == "address":
+		fp.handleAccount(w, path[1])
+	case r.Method == http.MethodPost && len(path) == 2 && path[0] == "transaction" && path[1] == "send":
+		fp.handleSendTransaction(w, r)
+	case r.Method == http.MethodPost && len(path) == 2 && path[0] == "transaction" && path[1] == "send-multiple":
+		fp.handleSendMultipleTransactions(w, r)
+	case r.Method == http.MethodGet && len(path) == 3 && path[0] == "transaction" && path[2] == "status":
+		fp.handleTransactionStatus(w, path[1])
+	default:
+		writeResponse(w, http.StatusNotFound, codeNotFound, nil, fmt.Errorf("%w: %s %s", errEndpointNotFound, r.Method, r.URL.Path))
+	}
+}
+
+func (fp *FakeProxy) delay(r *http.Request) bool {
+	fp.mutBehaviour.RLock()
+	latency := fp.latency
+	fp.mutBehaviour.RUnlock()
+
+	if latency == 0 {
+		return true
+	}
+
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+func (fp *FakeProxy) injectedSendError() error {
+	fp.mutBehaviour.Lock()
+	defer fp.mutBehaviour.Unlock()
+
+	if fp.numFailSends == 0 {
+		return nil
+	}
+
+	fp.numFailSends--
+	return fmt.Errorf("%w: %s", errInjectedSendFailure, fp.failSendErrMsg)
+}
+
+func (fp *FakeProxy) handleNetworkConfig(w http.ResponseWriter) {
+	writeResponse(w, http.StatusOK, codeSuccessful, map[string]interface{}{"config": fp.networkConfig}, nil)
+}
+
+// handleNetworkStatus reports the shard as synced, its nonce being the number of executed txs
+func (fp *FakeProxy) handleNetworkStatus(w http.ResponseWriter, shard string) {
+	shardID, err := strconv.ParseUint(shard, 10, 32)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, codeBadRequest, nil, fmt.Errorf("%w: %s", errInvalidShardID, shard))
+		return
+	}
+
+	nonce := uint64(len(fp.ledger.getExecutedTxs()))
+	writeResponse(w, http.StatusOK, codeSuccessful, map[string]interface{}{
+		"status": &data.NetworkStatus{
+			Nonce:                nonce,
+			HighestNonce:         nonce,
+			ProbableHighestNonce: nonce,
+			ShardID:              uint32(shardID),
+		},
+	}, nil)
+}
+
+func (fp *FakeProxy) handleAccount(w http.ResponseWriter, address string) {
+	pubKey, err := sdkCore.AddressPublicKeyConverter.Decode(address)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, codeBadRequest, nil, fmt.Errorf("%w: %s", errInvalidAddress, address))
+		return
+	}
+
+	nonce, balance := fp.ledger.getAccount(address)
+	account := &data.Account{
+		Address: address,
+		Nonce:   nonce,
+		Balance: balance.String(),
+	}
+	if chainCore.IsSmartContractAddress(pubKey) {
+		account.CodeHash = keccak.NewKeccak().Compute(address)
+	}
+
+	writeResponse(w, http.StatusOK, codeSuccessful, map[string]interface{}{"account": account}, nil)
+}
+
+func (fp *FakeProxy) handleSendTransaction(w http.ResponseWriter, r *http.Request) {
+	tx := &transaction.FrontendTransaction{}
+	err := decodeBody(r, tx)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, codeBadRequest, nil, err)
+		return
+	}
+
+	hash, err := fp.processTx(tx)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, codeBadRequest, nil, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, codeSuccessful, map[string]interface{}{"txHash": hash}, nil)
+}
+
+// handleSendMultipleTransactions processes all txs, returning the hashes of the accepted ones indexed by their
+// position in the request. Rejected txs are skipped, as the real proxy does.
+func (fp *FakeProxy) handleSendMultipleTransactions(w http.ResponseWriter, r *http.Request) {
+	txs := make([]*transaction.FrontendTransaction, 0)
+	err := decodeBody(r, &txs)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, codeBadRequest, nil, err)
+		return
+	}
+
+	hashes := make(map[int]string)
+	for idx, tx := range txs {
+		hash, errProcess := fp.processTx(tx)
+		if errProcess != nil {
+			continue
+		}
+
+		hashes[idx] = hash
+	}
+
+	writeResponse(w, http.StatusOK, codeSuccessful, map[string]interface{}{
+		"numOfSentTxs": len(hashes),
+		"txsHashes":    hashes,
+	}, nil)
+}
+
+func (fp *FakeProxy) handleTransactionStatus(w http.ResponseWriter, hash string) {
+	status, err := fp.ledger.getTxStatus(hash)
+	if err != nil {
+		writeResponse(w, http.StatusNotFound, codeNotFound, nil, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, codeSuccessful, map[string]interface{}{"status": status}, nil)
+}
+
+func (fp *FakeProxy) processTx(tx *transaction.FrontendTransaction) (string, error) {
+	err := fp.validateTx(tx)
+	if err != nil {
+		log.Debug("rejected tx", "sender", tx.Sender, "nonce", tx.Nonce, "error", err)
+		return "", err
+	}
+
+	txHash, err := fp.txBuilder.ComputeTxHash(tx)
+	if err != nil {
+		return "", err
+	}
+
+	hash := hex.EncodeToString(txHash)
+	err = fp.ledger.addTx(hash, tx)
+	if err != nil {
+		log.Debug("rejected tx", "hash", hash, "sender", tx.Sender, "nonce", tx.Nonce, "error", err)
+		return "", err
+	}
+
+	log.Debug("accepted tx", "hash", hash, "sender", tx.Sender, "nonce", tx.Nonce)
+	return hash, nil
+}
+
+func (fp *FakeProxy) validateTx(tx *transaction.FrontendTransaction) error {
+	if tx.ChainID != fp.networkConfig.ChainID {
+		return fmt.Errorf("%w: %s, expected: %s", errInvalidChainID, tx.ChainID, fp.networkConfig.ChainID)
+	}
+	if tx.Version < fp.networkConfig.MinTransactionVersion {
+		return fmt.Errorf("%w: %d, min version: %d", errInvalidVersion, tx.Version, fp.networkConfig.MinTransactionVersion)
+	}
+	if tx.GasPrice < fp.networkConfig.MinGasPrice {
+		return fmt.Errorf("%w: %d, min gas price: %d", errGasPriceTooLow, tx.GasPrice, fp.networkConfig.MinGasPrice)
+	}
+
+	minGasLimit := fp.networkConfig.MinGasLimit + uint64(len(tx.Data))*fp.networkConfig.GasPerDataByte
+	if tx.GasLimit < minGasLimit {
+		return fmt.Errorf("%w: %d, min gas limit: %d", errGasLimitTooLow, tx.GasLimit, minGasLimit)
+	}
+
+	return fp.verifySignature(tx)
+}
+
+func (fp *FakeProxy) verifySignature(tx *transaction.FrontendTransaction) error {
+	senderPubKey, err := sdkCore.AddressPublicKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidAddress, tx.Sender)
+	}
+	_, err = sdkCore.AddressPublicKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidAddress, tx.Receiver)
+	}
+
+	publicKey, err := fp.keyGen.PublicKeyFromByteArray(senderPubKey)
+	if err != nil {
+		return err
+	}
+
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidSignature, err)
+	}
+
+	message, err := json.Marshal(builders.TransactionToUnsignedTx(tx))
+	if err != nil {
+		return err
+	}
+
+	shouldSignOnTxHash := tx.Version >= 2 && tx.Options&1 > 0
+	if shouldSignOnTxHash {
+		message = keccak.NewKeccak().Compute(string(message))
+	}
+
+	err = fp.signer.VerifyByteSlice(message, publicKey, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidSignature, err)
+	}
+
+	return nil
+}
+
+func decodeBody(r *http.Request, value interface{}) error {
+	buff, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, value)
+}
+
+func writeResponse(w http.ResponseWriter, status int, code string, responseData interface{}, err error) {
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	buff, errMarshal := json.Marshal(map[string]interface{}{
+		"data":  responseData,
+		"error": errMsg,
+		"code":  code,
+	})
+	if errMarshal != nil {
+		status = http.StatusInternalServerError
+		buff = []byte(fmt.Sprintf(`{"error":%q,"code":%q}`, errMarshal.Error(), codeInternalIssue))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buff)
+}
+
+// SetLatency delays all following requests with the provided duration
+func (fp *FakeProxy) SetLatency(latency time.Duration) {
+	fp.mutBehaviour.Lock()
+	fp.latency = latency
+	fp.mutBehaviour.Unlock()
+}
+
+// FailNextSends rejects the next numRequests send transaction(s) requests with an internal error, without
+// processing their txs
+func (fp *FakeProxy) FailNextSends(numRequests int, errMsg string) {
+	fp.mutBehaviour.Lock()
+	fp.numFailSends = numRequests
+	fp.failSendErrMsg = errMsg
+	fp.mutBehaviour.Unlock()
+}
+
+// SetAccount overwrites the nonce and balance of the account
+func (fp *FakeProxy) SetAccount(address string, nonce uint64, balance *big.Int) {
+	fp.ledger.setAccount(address, nonce, balance)
+}
+
+// GetAccount returns the current nonce and balance of the account
+func (fp *FakeProxy) GetAccount(address string) (uint64, *big.Int) {
+	return fp.ledger.getAccount(address)
+}
+
+// GetTransactionStatus returns the status of a received tx
+func (fp *FakeProxy) GetTransactionStatus(hash string) (transaction.TxStatus, error) {
+	return fp.ledger.getTxStatus(hash)
+}
+
+// GetExecutedTransactions returns all executed txs, in execution order
+func (fp *FakeProxy) GetExecutedTransactions() []*transaction.FrontendTransaction {
+	return fp.ledger.getExecutedTxs()
+}
+
+// GetNetworkConfig returns the network config served by the fake proxy
+func (fp *FakeProxy) GetNetworkConfig() data.NetworkConfig {
+	return *fp.networkConfig
+}
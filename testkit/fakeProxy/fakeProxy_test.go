@@ -0,0 +1,281 @@
+package fakeProxy
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing/ed25519"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/require"
+)
+
+type sdkProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetAccount(ctx context.Context, address sdkCore.AddressHandler) (*data.Account, error)
+	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
+	GetTransactionStatus(ctx context.Context, hash string) (string, error)
+	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+}
+
+type testEnv struct {
+	fakeProxy *FakeProxy
+	proxy     sdkProxy
+	wallet    sdkCore.CryptoComponentsHolder
+	receiver  string
+}
+
+func createWallet(t *testing.T) sdkCore.CryptoComponentsHolder {
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	sk, _ := keyGen.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+
+	wallet, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, skBytes)
+	require.Nil(t, err)
+
+	return wallet
+}
+
+func createTestEnv(t *testing.T, args ArgsFakeProxy) *testEnv {
+	fakeProxy := NewFakeProxy(args)
+	httpServer := httptest.NewServer(fakeProxy)
+	t.Cleanup(httpServer.Close)
+
+	proxy, err := blockchain.NewProxy(blockchain.ArgsProxy{
+		ProxyURL:            httpServer.URL,
+		CacheExpirationTime: time.Minute,
+		EntityType:          sdkCore.Proxy,
+	})
+	require.Nil(t, err)
+
+	return &testEnv{
+		fakeProxy: fakeProxy,
+		proxy:     proxy,
+		wallet:    createWallet(t),
+		receiver:  createWallet(t).GetBech32(),
+	}
+}
+
+func (env *testEnv) createTx(t *testing.T, nonce uint64) *transaction.FrontendTransaction {
+	networkConfig := env.fakeProxy.GetNetworkConfig()
+	tx := &transaction.FrontendTransaction{
+		Nonce:    nonce,
+		Value:    "1000",
+		Receiver: env.receiver,
+		GasPrice: networkConfig.MinGasPrice,
+		GasLimit: networkConfig.MinGasLimit + 10*networkConfig.GasPerDataByte,
+		Data:     []byte("0123456789"),
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+	}
+
+	env.sign(t, tx)
+
+	return tx
+}
+
+func (env *testEnv) sign(t *testing.T, tx *transaction.FrontendTransaction) {
+	txBuilder, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	require.Nil(t, txBuilder.ApplyUserSignature(env.wallet, tx))
+}
+
+func TestFakeProxy_NetworkConfigAndAccount(t *testing.T) {
+	t.Parallel()
+
+	env := createTestEnv(t, ArgsFakeProxy{ChainID: "test-chain"})
+
+	networkConfig, err := env.proxy.GetNetworkConfig(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, "test-chain", networkConfig.ChainID)
+	require.Equal(t, uint64(defaultMinGasPrice), networkConfig.MinGasPrice)
+
+	balance := big.NewInt(12345)
+	env.fakeProxy.SetAccount(env.wallet.GetBech32(), 7, balance)
+
+	account, err := env.proxy.GetAccount(context.Background(), env.wallet.GetAddressHandler())
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), account.Nonce)
+	require.Equal(t, balance.String(), account.Balance)
+}
+
+func TestFakeProxy_NetworkStatusAndContracts(t *testing.T) {
+	t.Parallel()
+
+	env := createTestEnv(t, ArgsFakeProxy{})
+
+	_, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 0))
+	require.Nil(t, err)
+
+	networkStatus, err := env.proxy.GetNetworkStatus(context.Background(), 0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), networkStatus.Nonce)
+	require.Equal(t, networkStatus.Nonce, networkStatus.ProbableHighestNonce)
+
+	account, err := env.proxy.GetAccount(context.Background(), env.wallet.GetAddressHandler())
+	require.Nil(t, err)
+	require.Empty(t, account.CodeHash)
+
+	scAddress, err := data.NewAddressFromBech32String("drt1qqqqqqqqqqqqqpgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsswdmc7")
+	require.Nil(t, err)
+	account, err = env.proxy.GetAccount(context.Background(), scAddress)
+	require.Nil(t, err)
+	require.NotEmpty(t, account.CodeHash)
+}
+
+func TestFakeProxy_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid tx should be executed", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{})
+		tx := env.createTx(t, 0)
+
+		hash, err := env.proxy.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.NotEmpty(t, hash)
+
+		status, err := env.proxy.GetTransactionStatus(context.Background(), hash)
+		require.Nil(t, err)
+		require.Equal(t, string(transaction.TxStatusSuccess), status)
+
+		nonce, _ := env.fakeProxy.GetAccount(env.wallet.GetBech32())
+		require.Equal(t, uint64(1), nonce)
+		_, receiverBalance := env.fakeProxy.GetAccount(env.receiver)
+		require.Equal(t, big.NewInt(0).Add(defaultInitialBalance, big.NewInt(1000)), receiverBalance)
+		require.Equal(t, []*transaction.FrontendTransaction{tx}, env.fakeProxy.GetExecutedTransactions())
+	})
+	t.Run("invalid signature should be rejected", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{})
+		tx := env.createTx(t, 0)
+		tx.Value = "2000"
+
+		_, err := env.proxy.SendTransaction(context.Background(), tx)
+		require.ErrorContains(t, err, errInvalidSignature.Error())
+	})
+	t.Run("invalid chain ID should be rejected", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{})
+		tx := env.createTx(t, 0)
+		tx.ChainID = "other-chain"
+
+		_, err := env.proxy.SendTransaction(context.Background(), tx)
+		require.ErrorContains(t, err, errInvalidChainID.Error())
+	})
+	t.Run("insufficient gas limit should be rejected", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{})
+		tx := env.createTx(t, 0)
+		tx.GasLimit = defaultMinGasLimit
+
+		_, err := env.proxy.SendTransaction(context.Background(), tx)
+		require.ErrorContains(t, err, errGasLimitTooLow.Error())
+	})
+	t.Run("insufficient balance should be rejected", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{InitialBalance: big.NewInt(1)})
+
+		_, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 0))
+		require.ErrorContains(t, err, errInsufficientBalance.Error())
+	})
+	t.Run("nonces should be validated", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{MaxNonceGap: 10})
+		env.fakeProxy.SetAccount(env.wallet.GetBech32(), 5, defaultInitialBalance)
+
+		_, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 4))
+		require.ErrorContains(t, err, errNonceTooLow.Error())
+
+		_, err = env.proxy.SendTransaction(context.Background(), env.createTx(t, 15))
+		require.ErrorContains(t, err, errNonceTooHigh.Error())
+
+		// gap txs are kept pending until the missing nonce is received
+		hash7, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 7))
+		require.Nil(t, err)
+		hash6, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 6))
+		require.Nil(t, err)
+
+		_, err = env.proxy.SendTransaction(context.Background(), env.createTx(t, 6))
+		require.ErrorContains(t, err, errDuplicatedTx.Error())
+
+		otherTx := env.createTx(t, 6)
+		otherTx.Value = "2000"
+		env.sign(t, otherTx)
+		_, err = env.proxy.SendTransaction(context.Background(), otherTx)
+		require.ErrorContains(t, err, errDuplicatedNonce.Error())
+
+		status, _ := env.fakeProxy.GetTransactionStatus(hash7)
+		require.Equal(t, transaction.TxStatusPending, status)
+
+		hash5, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 5))
+		require.Nil(t, err)
+
+		for _, hash := range []string{hash5, hash6, hash7} {
+			status, _ = env.fakeProxy.GetTransactionStatus(hash)
+			require.Equal(t, transaction.TxStatusSuccess, status)
+		}
+
+		nonce, _ := env.fakeProxy.GetAccount(env.wallet.GetBech32())
+		require.Equal(t, uint64(8), nonce)
+	})
+}
+
+func TestFakeProxy_SendTransactions(t *testing.T) {
+	t.Parallel()
+
+	env := createTestEnv(t, ArgsFakeProxy{})
+	invalidTx := env.createTx(t, 1)
+	invalidTx.Signature = "00"
+	txs := []*transaction.FrontendTransaction{env.createTx(t, 0), invalidTx, env.createTx(t, 1)}
+
+	hashes, err := env.proxy.SendTransactions(context.Background(), txs)
+	require.Nil(t, err)
+	require.Len(t, hashes, 2)
+	require.Equal(t, []*transaction.FrontendTransaction{txs[0], txs[2]}, env.fakeProxy.GetExecutedTransactions())
+}
+
+func TestFakeProxy_UnknownEndpoint(t *testing.T) {
+	t.Parallel()
+
+	httpServer := httptest.NewServer(NewFakeProxy(ArgsFakeProxy{}))
+	defer httpServer.Close()
+
+	res, err := http.Get(httpServer.URL + "/network/economics")
+	require.Nil(t, err)
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	_, err = NewFakeProxy(ArgsFakeProxy{}).GetTransactionStatus("missing")
+	require.ErrorIs(t, err, errTxNotFound)
+}
+
+func TestFakeProxy_InjectedBehaviour(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed sends should not process txs", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{})
+		env.fakeProxy.FailNextSends(1, "node unavailable")
+
+		_, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 0))
+		require.ErrorContains(t, err, "node unavailable")
+		require.Empty(t, env.fakeProxy.GetExecutedTransactions())
+
+		_, err = env.proxy.SendTransaction(context.Background(), env.createTx(t, 0))
+		require.Nil(t, err)
+		require.Len(t, env.fakeProxy.GetExecutedTransactions(), 1)
+	})
+	t.Run("latency should be applied", func(t *testing.T) {
+		env := createTestEnv(t, ArgsFakeProxy{})
+		env.fakeProxy.SetLatency(time.Millisecond * 50)
+
+		start := time.Now()
+		_, err := env.proxy.SendTransaction(context.Background(), env.createTx(t, 0))
+		require.Nil(t, err)
+		require.GreaterOrEqual(t, time.Since(start), time.Millisecond*50)
+	})
+}
@@ -0,0 +1,14 @@
+package fakeProxy
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	crypto "github.com/TerraDharitri/drt-go-chain-crypto"
+)
+
+type signatureVerifier interface {
+	VerifyByteSlice(msg []byte, publicKey crypto.PublicKey, sig []byte) error
+}
+
+type txHashComputer interface {
+	ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error)
+}
@@ -0,0 +1,177 @@
+package fakeProxy
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+)
+
+type account struct {
+	nonce   uint64
+	balance *big.Int
+	pending map[uint64]*txRecord
+}
+
+type txRecord struct {
+	hash   string
+	tx     *transaction.FrontendTransaction
+	status transaction.TxStatus
+}
+
+// ledger keeps the accounts state in memory. As a node's mempool, it accepts txs with nonce gaps, executing them
+// only once all previous nonces of the sender were executed.
+type ledger struct {
+	mut            sync.RWMutex
+	accounts       map[string]*account
+	txs            map[string]*txRecord
+	executed       []*txRecord
+	initialBalance *big.Int
+	maxNonceGap    uint64
+}
+
+func newLedger(initialBalance *big.Int, maxNonceGap uint64) *ledger {
+	return &ledger{
+		accounts:       make(map[string]*account),
+		txs:            make(map[string]*txRecord),
+		executed:       make([]*txRecord, 0),
+		initialBalance: initialBalance,
+		maxNonceGap:    maxNonceGap,
+	}
+}
+
+func (l *ledger) getOrCreateAccount(address string) *account {
+	acc, found := l.accounts[address]
+	if found {
+		return acc
+	}
+
+	acc = &account{
+		balance: big.NewInt(0).Set(l.initialBalance),
+		pending: make(map[uint64]*txRecord),
+	}
+	l.accounts[address] = acc
+
+	return acc
+}
+
+func (l *ledger) setAccount(address string, nonce uint64, balance *big.Int) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	acc := l.getOrCreateAccount(address)
+	acc.nonce = nonce
+	acc.balance = big.NewInt(0).Set(balance)
+}
+
+func (l *ledger) getAccount(address string) (uint64, *big.Int) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	acc := l.getOrCreateAccount(address)
+	return acc.nonce, big.NewInt(0).Set(acc.balance)
+}
+
+func (l *ledger) addTx(hash string, tx *transaction.FrontendTransaction) error {
+	cost, err := computeCost(tx)
+	if err != nil {
+		return err
+	}
+
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	if _, found := l.txs[hash]; found {
+		return fmt.Errorf("%w: %s", errDuplicatedTx, hash)
+	}
+
+	acc := l.getOrCreateAccount(tx.Sender)
+	if tx.Nonce < acc.nonce {
+		return fmt.Errorf("%w, tx nonce: %d, account nonce: %d", errNonceTooLow, tx.Nonce, acc.nonce)
+	}
+	if tx.Nonce >= acc.nonce+l.maxNonceGap {
+		return fmt.Errorf("%w, tx nonce: %d, account nonce: %d", errNonceTooHigh, tx.Nonce, acc.nonce)
+	}
+	if _, found := acc.pending[tx.Nonce]; found {
+		return fmt.Errorf("%w, tx nonce: %d", errDuplicatedNonce, tx.Nonce)
+	}
+	if acc.balance.Cmp(cost) < 0 {
+		return fmt.Errorf("%w, balance: %s, tx cost: %s", errInsufficientBalance, acc.balance, cost)
+	}
+
+	record := &txRecord{
+		hash:   hash,
+		tx:     tx,
+		status: transaction.TxStatusPending,
+	}
+	l.txs[hash] = record
+	acc.pending[tx.Nonce] = record
+
+	l.executePending(acc)
+	return nil
+}
+
+func (l *ledger) executePending(acc *account) {
+	for {
+		record, found := acc.pending[acc.nonce]
+		if !found {
+			return
+		}
+
+		delete(acc.pending, acc.nonce)
+		acc.nonce++
+		l.execute(acc, record)
+	}
+}
+
+func (l *ledger) execute(sender *account, record *txRecord) {
+	l.executed = append(l.executed, record)
+
+	cost, _ := computeCost(record.tx)
+	if sender.balance.Cmp(cost) < 0 {
+		// the nonce is consumed even if the tx fails, as on chain
+		record.status = transaction.TxStatusFail
+		return
+	}
+
+	value, _ := big.NewInt(0).SetString(record.tx.Value, 10)
+	sender.balance.Sub(sender.balance, cost)
+	receiver := l.getOrCreateAccount(record.tx.Receiver)
+	receiver.balance.Add(receiver.balance, value)
+	record.status = transaction.TxStatusSuccess
+}
+
+func computeCost(tx *transaction.FrontendTransaction) (*big.Int, error) {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidValue, tx.Value)
+	}
+
+	fee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasLimit), big.NewInt(0).SetUint64(tx.GasPrice))
+	return fee.Add(fee, value), nil
+}
+
+func (l *ledger) getTxStatus(hash string) (transaction.TxStatus, error) {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+
+	record, found := l.txs[hash]
+	if !found {
+		return "", fmt.Errorf("%w: %s", errTxNotFound, hash)
+	}
+
+	return record.status, nil
+}
+
+func (l *ledger) getExecutedTxs() []*transaction.FrontendTransaction {
+	l.mut.RLock()
+	defer l.mut.RUnlock()
+
+	txs := make([]*transaction.FrontendTransaction, 0, len(l.executed))
+	for _, record := range l.executed {
+		txs = append(txs, record.tx)
+	}
+
+	return txs
+}
@@ -0,0 +1,195 @@
+package testkit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+)
+
+// FakeTxSender is a configurable server.TxSender which records all received bridge operations instead of sending
+// txs. It creates, as the real tx sender, one register tx for each bridge data and one execute tx for each operation,
+// returning deterministic fake tx hashes. Latency, errors and partial failures can be injected at any time.
+type FakeTxSender struct {
+	mut             sync.RWMutex
+	latency         time.Duration
+	err             error
+	numFailNext     int
+	failNextErr     error
+	failEvery       int
+	failEveryErr    error
+	received        []*sovereign.BridgeOperations
+	numCalls        int
+	numCreatedTxs   uint64
+	receivedChanged chan struct{}
+}
+
+// NewFakeTxSender creates a fake tx sender which successfully handles all requests, with no latency
+func NewFakeTxSender() *FakeTxSender {
+	return &FakeTxSender{
+		received:        make([]*sovereign.BridgeOperations, 0),
+		receivedChanged: make(chan struct{}),
+	}
+}
+
+// SetLatency delays each request with the provided duration, or until the request context is done
+func (fts *FakeTxSender) SetLatency(latency time.Duration) {
+	fts.mut.Lock()
+	fts.latency = latency
+	fts.mut.Unlock()
+}
+
+// SetError makes all following requests fail with the provided error. A nil error makes them succeed again.
+func (fts *FakeTxSender) SetError(err error) {
+	fts.mut.Lock()
+	fts.err = err
+	fts.mut.Unlock()
+}
+
+// FailNext makes the next numRequests requests fail with the provided error, simulating a transient failure
+func (fts *FakeTxSender) FailNext(numRequests int, err error) {
+	fts.mut.Lock()
+	fts.numFailNext = numRequests
+	fts.failNextErr = err
+	fts.mut.Unlock()
+}
+
+// FailEvery makes every n-th request fail with the provided error, simulating partial failures in a stream of
+// requests. Zero disables it.
+func (fts *FakeTxSender) FailEvery(n int, err error) {
+	fts.mut.Lock()
+	fts.failEvery = n
+	fts.failEveryErr = err
+	fts.mut.Unlock()
+}
+
+// SendTxs records the bridge operations and returns fake tx hashes, unless a failure was injected. Failed requests
+// are recorded as well.
+func (fts *FakeTxSender) SendTxs(ctx context.Context, data *sovereign.BridgeOperations) ([]string, error) {
+	fts.mut.RLock()
+	latency := fts.latency
+	fts.mut.RUnlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	fts.mut.Lock()
+	defer fts.mut.Unlock()
+
+	fts.numCalls++
+	fts.received = append(fts.received, data)
+	close(fts.receivedChanged)
+	fts.receivedChanged = make(chan struct{})
+
+	err := fts.injectedError()
+	if err != nil {
+		return nil, err
+	}
+
+	return fts.createTxHashes(data), nil
+}
+
+func (fts *FakeTxSender) injectedError() error {
+	if fts.numFailNext > 0 {
+		fts.numFailNext--
+		return errOrDefault(fts.failNextErr)
+	}
+	if fts.failEvery > 0 && fts.numCalls%fts.failEvery == 0 {
+		return errOrDefault(fts.failEveryErr)
+	}
+
+	return fts.err
+}
+
+func errOrDefault(err error) error {
+	if err != nil {
+		return err
+	}
+
+	return ErrInjected
+}
+
+func (fts *FakeTxSender) createTxHashes(data *sovereign.BridgeOperations) []string {
+	hashes := make([]string, 0)
+	for _, bridgeData := range data.GetData() {
+		numTxs := 1 + len(bridgeData.GetOutGoingOperations())
+		for i := 0; i < numTxs; i++ {
+			fts.numCreatedTxs++
+			hashes = append(hashes, fakeTxHash(fts.numCreatedTxs))
+		}
+	}
+
+	return hashes
+}
+
+func fakeTxHash(nonce uint64) string {
+	hash := sha256.Sum256(binary.BigEndian.AppendUint64(nil, nonce))
+	return hex.EncodeToString(hash[:])
+}
+
+// Received returns all bridge operations received so far, in order
+func (fts *FakeTxSender) Received() []*sovereign.BridgeOperations {
+	fts.mut.RLock()
+	defer fts.mut.RUnlock()
+
+	received := make([]*sovereign.BridgeOperations, len(fts.received))
+	copy(received, fts.received)
+	return received
+}
+
+// NumCalls returns the number of received requests, including the failed ones
+func (fts *FakeTxSender) NumCalls() int {
+	fts.mut.RLock()
+	defer fts.mut.RUnlock()
+
+	return fts.numCalls
+}
+
+// WaitForCalls blocks until at least numCalls requests were received or the context is done
+func (fts *FakeTxSender) WaitForCalls(ctx context.Context, numCalls int) error {
+	for {
+		fts.mut.RLock()
+		done := fts.numCalls >= numCalls
+		changed := fts.receivedChanged
+		fts.mut.RUnlock()
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Reset removes all received bridge operations and injected failures
+func (fts *FakeTxSender) Reset() {
+	fts.mut.Lock()
+	defer fts.mut.Unlock()
+
+	fts.latency = 0
+	fts.err = nil
+	fts.numFailNext = 0
+	fts.failEvery = 0
+	fts.received = make([]*sovereign.BridgeOperations, 0)
+	fts.numCalls = 0
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (fts *FakeTxSender) IsInterfaceNil() bool {
+	return fts == nil
+}
@@ -0,0 +1,9 @@
+package scenario
+
+import "errors"
+
+var errUnknownScenarioFormat = errors.New("unknown scenario format, acceptable: .yaml, .yml, .json")
+
+var errUnknownReceiver = errors.New("unknown expected tx receiver")
+
+var errInvalidBridgeDataIndex = errors.New("invalid expected tx bridge data index")
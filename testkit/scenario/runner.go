@@ -0,0 +1,306 @@
+package scenario
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/factory"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/interactors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/client"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/idempotency"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/operations"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/cmd/config"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testkit/fakeProxy"
+)
+
+const (
+	hasherType     = "sha256"
+	intervalToSend = 1
+	numWorkers     = 2
+	bufConnSize    = 1024 * 1024
+	defaultTimeout = time.Second * 10
+)
+
+// environment holds the bridge server components created with server.CreateComponents, connected to a fake proxy
+type environment struct {
+	fakeProxy      *fakeProxy.FakeProxy
+	client         client.ClientHandler
+	hasher         hashing.Hasher
+	walletAddress  string
+	receivers      map[string]string
+	numExecutedTxs int
+}
+
+// RunDir runs all scenario files from the directory, each one as a subtest on a new bridge server
+func RunDir(t *testing.T, dir string) {
+	files, err := ListFiles(dir)
+	require.Nil(t, err)
+	require.NotEmpty(t, files, "no scenarios found in %s", dir)
+
+	for _, file := range files {
+		path := file
+		t.Run(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), func(t *testing.T) {
+			RunFile(t, path)
+		})
+	}
+}
+
+// RunFile runs the scenario from the file on a new bridge server
+func RunFile(t *testing.T, path string) {
+	scenario, err := LoadFile(path)
+	require.Nil(t, err)
+
+	Run(t, scenario)
+}
+
+// Run runs the scenario on a new bridge server
+func Run(t *testing.T, scenario *Scenario) {
+	env := createEnvironment(t, scenario)
+
+	for idx, step := range scenario.Steps {
+		stepName := step.Name
+		if len(stepName) == 0 {
+			stepName = fmt.Sprintf("step %d", idx)
+		}
+
+		env.runStep(t, fmt.Sprintf("%s: %s", scenario.Name, stepName), step)
+	}
+}
+
+func createEnvironment(t *testing.T, scenario *Scenario) *environment {
+	proxy := fakeProxy.NewFakeProxy(fakeProxy.ArgsFakeProxy{})
+	httpServer := httptest.NewServer(proxy)
+	t.Cleanup(httpServer.Close)
+
+	walletPath, walletAddress := createWallet(t)
+	_, balance := proxy.GetAccount(walletAddress)
+	proxy.SetAccount(walletAddress, scenario.InitialNonce, balance)
+
+	receivers := map[string]string{
+		HeaderVerifierReceiver: createAddress(t, HeaderVerifierReceiver),
+		DcdtSafeReceiver:       createAddress(t, DcdtSafeReceiver),
+	}
+
+	components, err := server.CreateComponents(&config.ServerConfig{
+		TxSenderConfig: txSender.TxSenderConfig{
+			HeaderVerifierSCAddress: receivers[HeaderVerifierReceiver],
+			DcdtSafeSCAddress:       receivers[DcdtSafeReceiver],
+			Proxy:                   httpServer.URL,
+			IntervalToSend:          intervalToSend,
+			Hasher:                  hasherType,
+			NumWorkers:              numWorkers,
+		},
+		WalletConfig: txSender.WalletConfig{
+			Path: walletPath,
+		},
+		AdminConfig: config.AdminConfig{
+			StateFile:    filepath.Join(t.TempDir(), "state.json"),
+			AuditLogFile: filepath.Join(t.TempDir(), "audit.log"),
+		},
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		require.Nil(t, components.HealthMonitor.Close())
+		require.Nil(t, components.TxSender.Close())
+	})
+
+	interceptors, err := server.NewUnaryInterceptors(components.Metrics, components.Metrics)
+	require.Nil(t, err)
+
+	listener := bufconn.Listen(bufConnSize)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	sovereign.RegisterBridgeTxSenderServer(grpcServer, components.BridgeServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	bridgeClient, err := client.NewBufConnClient(context.Background(), listener)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = bridgeClient.Close()
+	})
+
+	hasher, err := factory.NewHasher(hasherType)
+	require.Nil(t, err)
+
+	return &environment{
+		fakeProxy:     proxy,
+		client:        bridgeClient,
+		hasher:        hasher,
+		walletAddress: walletAddress,
+		receivers:     receivers,
+	}
+}
+
+// createWallet saves a new wallet, derived from the test name, so that each scenario has its own sender
+func createWallet(t *testing.T) (string, string) {
+	privateKey := sha256.Sum256([]byte(t.Name()))
+	walletPath := filepath.Join(t.TempDir(), "wallet.pem")
+
+	w := interactors.NewWallet()
+	err := w.SavePrivateKeyToPemFile(privateKey[:], walletPath)
+	require.Nil(t, err)
+
+	address, err := w.GetAddressFromPrivateKey(privateKey[:])
+	require.Nil(t, err)
+	bech32, err := address.AddressAsBech32String()
+	require.Nil(t, err)
+
+	return walletPath, bech32
+}
+
+func createAddress(t *testing.T, name string) string {
+	pubKey := sha256.Sum256([]byte(name))
+	bech32, err := data.NewAddressFromBytes(pubKey[:]).AddressAsBech32String()
+	require.Nil(t, err)
+
+	return bech32
+}
+
+func (env *environment) runStep(t *testing.T, name string, step Step) {
+	env.applyProxyBehaviour(step.Proxy)
+
+	request := env.createRequest(step.Request)
+	ctx, cancel := env.createContext(step)
+	defer cancel()
+
+	res, err := env.client.Send(ctx, request)
+
+	executedTxs := env.fakeProxy.GetExecutedTransactions()[env.numExecutedTxs:]
+	env.numExecutedTxs += len(executedTxs)
+
+	if len(step.Expect.Error) != 0 {
+		require.ErrorContains(t, err, step.Expect.Error, name)
+	} else {
+		require.Nil(t, err, name)
+		env.requireExecuted(t, name, res.TxHashes, len(executedTxs))
+	}
+
+	expectedTxs := env.createExpectedTxs(t, name, request, step.Expect.Txs)
+	require.Equal(t, expectedTxs, simplifyTxs(executedTxs), name)
+}
+
+func (env *environment) applyProxyBehaviour(behaviour ProxyBehaviour) {
+	env.fakeProxy.SetLatency(time.Millisecond * time.Duration(behaviour.LatencyInMs))
+	env.fakeProxy.FailNextSends(behaviour.FailNextSends, behaviour.FailMessage)
+
+	if behaviour.NonceDrift > 0 {
+		nonce, balance := env.fakeProxy.GetAccount(env.walletAddress)
+		env.fakeProxy.SetAccount(env.walletAddress, nonce+behaviour.NonceDrift, balance)
+	}
+}
+
+func (env *environment) createRequest(bridgeDataList []BridgeData) *sovereign.BridgeOperations {
+	request := &sovereign.BridgeOperations{
+		Data: make([]*sovereign.BridgeOutGoingData, 0, len(bridgeDataList)),
+	}
+
+	for _, bridgeData := range bridgeDataList {
+		outGoingData := &sovereign.BridgeOutGoingData{
+			Hash:                []byte(bridgeData.Hash),
+			AggregatedSignature: []byte(bridgeData.AggregatedSignature),
+			LeaderSignature:     []byte(bridgeData.LeaderSignature),
+		}
+		for _, operation := range bridgeData.Operations {
+			outGoingData.OutGoingOperations = append(outGoingData.OutGoingOperations, &sovereign.OutGoingOperation{
+				Hash: []byte(operation.Hash),
+				Data: []byte(operation.Data),
+			})
+		}
+		if len(bridgeData.Hash) == 0 {
+			outGoingData.Hash = operations.ComputeHashOfHashes(env.hasher, outGoingData.OutGoingOperations)
+		}
+
+		request.Data = append(request.Data, outGoingData)
+	}
+
+	return request
+}
+
+func (env *environment) createContext(step Step) (context.Context, context.CancelFunc) {
+	timeout := defaultTimeout
+	if step.TimeoutInMs > 0 {
+		timeout = time.Millisecond * time.Duration(step.TimeoutInMs)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	if len(step.IdempotencyKey) != 0 {
+		ctx = idempotency.AppendToOutgoingContext(ctx, step.IdempotencyKey)
+	}
+
+	return ctx, cancel
+}
+
+func (env *environment) requireExecuted(t *testing.T, name string, txHashes []string, numExecutedTxs int) {
+	require.Len(t, txHashes, numExecutedTxs, name)
+	for _, hash := range txHashes {
+		status, err := env.fakeProxy.GetTransactionStatus(hash)
+		require.Nil(t, err, name)
+		require.Equal(t, transaction.TxStatusSuccess, status, name)
+	}
+}
+
+// simpleTx holds the tx fields checked by scenarios
+type simpleTx struct {
+	Nonce    uint64
+	Receiver string
+	Data     string
+}
+
+func simplifyTxs(txs []*transaction.FrontendTransaction) []simpleTx {
+	simpleTxs := make([]simpleTx, 0, len(txs))
+	for _, tx := range txs {
+		simpleTxs = append(simpleTxs, simpleTx{
+			Nonce:    tx.Nonce,
+			Receiver: tx.Receiver,
+			Data:     string(tx.Data),
+		})
+	}
+
+	return simpleTxs
+}
+
+func (env *environment) createExpectedTxs(t *testing.T, name string, request *sovereign.BridgeOperations, expectedTxs []ExpectedTx) []simpleTx {
+	simpleTxs := make([]simpleTx, 0, len(expectedTxs))
+	for _, expectedTx := range expectedTxs {
+		receiver, found := env.receivers[expectedTx.Receiver]
+		require.True(t, found, "%s: %v: %s", name, errUnknownReceiver, expectedTx.Receiver)
+
+		args := make([]string, 0, len(expectedTx.Args))
+		for _, arg := range expectedTx.Args {
+			if arg != HashPlaceholder {
+				args = append(args, hex.EncodeToString([]byte(arg)))
+				continue
+			}
+
+			require.True(t, expectedTx.BridgeData < len(request.Data), "%s: %v: %d", name, errInvalidBridgeDataIndex, expectedTx.BridgeData)
+			args = append(args, hex.EncodeToString(request.Data[expectedTx.BridgeData].Hash))
+		}
+
+		simpleTxs = append(simpleTxs, simpleTx{
+			Nonce:    expectedTx.Nonce,
+			Receiver: receiver,
+			Data:     strings.Join(append([]string{expectedTx.Function}, args...), "@"),
+		})
+	}
+
+	return simpleTxs
+}
@@ -0,0 +1,126 @@
+package scenario
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// HeaderVerifierReceiver identifies the header verifier contract in expected txs
+	HeaderVerifierReceiver = "headerVerifier"
+	// DcdtSafeReceiver identifies the dcdt safe contract in expected txs
+	DcdtSafeReceiver = "dcdtSafe"
+	// HashPlaceholder is replaced, in expected txs arguments, with the hex encoded hash of the bridge data
+	HashPlaceholder = "$hash"
+)
+
+// Scenario describes the bridge operations received by the server, the proxy behaviour and the expected outcome
+// of each request. Byte fields are written as plain text, so hashes should be 32 characters long, the size of the
+// sha256 hashes checked by the server.
+type Scenario struct {
+	Name         string `yaml:"name" json:"name"`
+	Description  string `yaml:"description" json:"description"`
+	InitialNonce uint64 `yaml:"initialNonce" json:"initialNonce"`
+	Steps        []Step `yaml:"steps" json:"steps"`
+}
+
+// Step is one request sent to the bridge server, after applying the proxy behaviour
+type Step struct {
+	Name           string         `yaml:"name" json:"name"`
+	Proxy          ProxyBehaviour `yaml:"proxy" json:"proxy"`
+	Request        []BridgeData   `yaml:"request" json:"request"`
+	IdempotencyKey string         `yaml:"idempotencyKey" json:"idempotencyKey"`
+	TimeoutInMs    int            `yaml:"timeoutInMs" json:"timeoutInMs"`
+	Expect         Expectation    `yaml:"expect" json:"expect"`
+}
+
+// ProxyBehaviour holds the fake proxy behaviour applied before sending the step's request. NonceDrift increases the
+// wallet's account nonce, as if txs were sent from the same wallet by someone else.
+type ProxyBehaviour struct {
+	LatencyInMs   int    `yaml:"latencyInMs" json:"latencyInMs"`
+	FailNextSends int    `yaml:"failNextSends" json:"failNextSends"`
+	FailMessage   string `yaml:"failMessage" json:"failMessage"`
+	NonceDrift    uint64 `yaml:"nonceDrift" json:"nonceDrift"`
+}
+
+// BridgeData describes one bridge outgoing data. If the hash is not provided, the hash of the operations hashes is
+// used, so that the bridge data is registered.
+type BridgeData struct {
+	Hash                string      `yaml:"hash" json:"hash"`
+	AggregatedSignature string      `yaml:"aggregatedSignature" json:"aggregatedSignature"`
+	LeaderSignature     string      `yaml:"leaderSignature" json:"leaderSignature"`
+	Operations          []Operation `yaml:"operations" json:"operations"`
+}
+
+// Operation describes one outgoing operation
+type Operation struct {
+	Hash string `yaml:"hash" json:"hash"`
+	Data string `yaml:"data" json:"data"`
+}
+
+// Expectation holds the expected response and the txs executed by the proxy while handling the request, in order.
+// An empty error means that the request should succeed and return the hashes of the executed txs.
+type Expectation struct {
+	Error string       `yaml:"error" json:"error"`
+	Txs   []ExpectedTx `yaml:"txs" json:"txs"`
+}
+
+// ExpectedTx describes an executed tx. Arguments are hex encoded before being compared with the tx data, except for
+// HashPlaceholder, which is replaced with the hash of the request's bridge data at index BridgeData.
+type ExpectedTx struct {
+	Nonce      uint64   `yaml:"nonce" json:"nonce"`
+	Receiver   string   `yaml:"receiver" json:"receiver"`
+	Function   string   `yaml:"function" json:"function"`
+	Args       []string `yaml:"args" json:"args"`
+	BridgeData int      `yaml:"bridgeData" json:"bridgeData"`
+}
+
+// LoadFile loads a scenario from a yaml or json file
+func LoadFile(path string) (*Scenario, error) {
+	buff, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	scenario := &Scenario{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(buff, scenario)
+	case ".json":
+		err = json.Unmarshal(buff, scenario)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownScenarioFormat, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w, scenario: %s", err, path)
+	}
+
+	if len(scenario.Name) == 0 {
+		scenario.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	return scenario, nil
+}
+
+// ListFiles returns all scenario files from the directory
+func ListFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,41 @@
+package scenario
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("yaml scenario", func(t *testing.T) {
+		scenario, err := LoadFile(filepath.Join("testdata", "register_and_execute.yaml"))
+		require.Nil(t, err)
+		require.Equal(t, "register_and_execute", scenario.Name)
+		require.Equal(t, uint64(10), scenario.InitialNonce)
+		require.Len(t, scenario.Steps, 1)
+		require.Len(t, scenario.Steps[0].Request[0].Operations, 2)
+		require.Equal(t, []string{"aggSig", HashPlaceholder, "opHash1.........................", "opHash2........................."}, scenario.Steps[0].Expect.Txs[0].Args)
+	})
+	t.Run("json scenario", func(t *testing.T) {
+		scenario, err := LoadFile(filepath.Join("testdata", "nonce_drift.json"))
+		require.Nil(t, err)
+		require.Len(t, scenario.Steps, 3)
+		require.Equal(t, uint64(3), scenario.Steps[1].Proxy.NonceDrift)
+		require.Equal(t, "drift", scenario.Steps[1].IdempotencyKey)
+	})
+	t.Run("unknown format should error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "scenario.txt")
+		require.Nil(t, os.WriteFile(path, []byte("name: test"), 0644))
+		scenario, err := LoadFile(path)
+		require.Nil(t, scenario)
+		require.ErrorIs(t, err, errUnknownScenarioFormat)
+	})
+}
+
+func TestScenarios(t *testing.T) {
+	RunDir(t, "testdata")
+}
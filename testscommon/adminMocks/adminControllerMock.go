@@ -0,0 +1,52 @@
+package adminMocks
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/admin"
+)
+
+// AdminControllerMock mocks AdminController interface
+type AdminControllerMock struct {
+	PauseCalled  func(mode admin.PauseMode) (admin.Status, error)
+	ResumeCalled func() (admin.Status, error)
+	DrainCalled  func(ctx context.Context) (admin.Status, error)
+	StatusCalled func() admin.Status
+}
+
+// Pause mocks the Pause method
+func (mock *AdminControllerMock) Pause(mode admin.PauseMode) (admin.Status, error) {
+	if mock.PauseCalled != nil {
+		return mock.PauseCalled(mode)
+	}
+	return admin.Status{}, nil
+}
+
+// Resume mocks the Resume method
+func (mock *AdminControllerMock) Resume() (admin.Status, error) {
+	if mock.ResumeCalled != nil {
+		return mock.ResumeCalled()
+	}
+	return admin.Status{}, nil
+}
+
+// Drain mocks the Drain method
+func (mock *AdminControllerMock) Drain(ctx context.Context) (admin.Status, error) {
+	if mock.DrainCalled != nil {
+		return mock.DrainCalled(ctx)
+	}
+	return admin.Status{}, nil
+}
+
+// Status mocks the Status method
+func (mock *AdminControllerMock) Status() admin.Status {
+	if mock.StatusCalled != nil {
+		return mock.StatusCalled()
+	}
+	return admin.Status{}
+}
+
+// IsInterfaceNil -
+func (mock *AdminControllerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,34 @@
+package adminMocks
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+)
+
+// SendingConfigUpdaterMock mocks SendingConfigUpdater interface
+type SendingConfigUpdaterMock struct {
+	SendingConfigCalled       func() txSender.SendingConfig
+	UpdateSendingConfigCalled func(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error)
+}
+
+// SendingConfig mocks the SendingConfig method
+func (mock *SendingConfigUpdaterMock) SendingConfig() txSender.SendingConfig {
+	if mock.SendingConfigCalled != nil {
+		return mock.SendingConfigCalled()
+	}
+	return txSender.SendingConfig{}
+}
+
+// UpdateSendingConfig mocks the UpdateSendingConfig method
+func (mock *SendingConfigUpdaterMock) UpdateSendingConfig(ctx context.Context, patch []byte, actor string) (txSender.SendingConfig, error) {
+	if mock.UpdateSendingConfigCalled != nil {
+		return mock.UpdateSendingConfigCalled(ctx, patch, actor)
+	}
+	return txSender.SendingConfig{}, nil
+}
+
+// IsInterfaceNil -
+func (mock *SendingConfigUpdaterMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,26 @@
+package testscommon
+
+// BridgeMetricsMock mocks BridgeMetrics interface
+type BridgeMetricsMock struct {
+	AddReceivedBridgeOperationsCalled func(numBridgeData int, numOperations int)
+	AddSendFailureCalled              func(reason string)
+}
+
+// AddReceivedBridgeOperations mocks the AddReceivedBridgeOperations method
+func (mock *BridgeMetricsMock) AddReceivedBridgeOperations(numBridgeData int, numOperations int) {
+	if mock.AddReceivedBridgeOperationsCalled != nil {
+		mock.AddReceivedBridgeOperationsCalled(numBridgeData, numOperations)
+	}
+}
+
+// AddSendFailure mocks the AddSendFailure method
+func (mock *BridgeMetricsMock) AddSendFailure(reason string) {
+	if mock.AddSendFailureCalled != nil {
+		mock.AddSendFailureCalled(reason)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *BridgeMetricsMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,21 @@
+package testscommon
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+
+// BridgeOperationsValidatorMock mocks BridgeOperationsValidator interface
+type BridgeOperationsValidatorMock struct {
+	ValidateCalled func(data *sovereign.BridgeOperations) error
+}
+
+// Validate mocks the Validate method
+func (mock *BridgeOperationsValidatorMock) Validate(data *sovereign.BridgeOperations) error {
+	if mock.ValidateCalled != nil {
+		return mock.ValidateCalled(data)
+	}
+	return nil
+}
+
+// IsInterfaceNil mocks the IsInterfaceNil method
+func (mock *BridgeOperationsValidatorMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,34 @@
+package testscommon
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+)
+
+// ClientHandlerMock mocks ClientHandler interface
+type ClientHandlerMock struct {
+	SendCalled  func(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error)
+	CloseCalled func() error
+}
+
+// Send mocks the Send method
+func (mock *ClientHandlerMock) Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+	if mock.SendCalled != nil {
+		return mock.SendCalled(ctx, data)
+	}
+	return &sovereign.BridgeOperationsResponse{}, nil
+}
+
+// Close mocks the Close method
+func (mock *ClientHandlerMock) Close() error {
+	if mock.CloseCalled != nil {
+		return mock.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *ClientHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,20 @@
+package testscommon
+
+import "math/big"
+
+// HealthMetricsMock mocks the health monitor Metrics interface
+type HealthMetricsMock struct {
+	SetWalletBalanceCalled func(balance *big.Int)
+}
+
+// SetWalletBalance mocks the SetWalletBalance method
+func (mock *HealthMetricsMock) SetWalletBalance(balance *big.Int) {
+	if mock.SetWalletBalanceCalled != nil {
+		mock.SetWalletBalanceCalled(balance)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *HealthMetricsMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,32 @@
+package testscommon
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/sovereign"
+
+// JournalWriterMock -
+type JournalWriterMock struct {
+	WriteCalled func(data *sovereign.BridgeOperations) error
+	CloseCalled func() error
+}
+
+// Write -
+func (mock *JournalWriterMock) Write(data *sovereign.BridgeOperations) error {
+	if mock.WriteCalled != nil {
+		return mock.WriteCalled(data)
+	}
+
+	return nil
+}
+
+// Close -
+func (mock *JournalWriterMock) Close() error {
+	if mock.CloseCalled != nil {
+		return mock.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *JournalWriterMock) IsInterfaceNil() bool {
+	return mock == nil
+}
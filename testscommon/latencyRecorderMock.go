@@ -0,0 +1,24 @@
+package testscommon
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// LatencyRecorderMock mocks LatencyRecorder interface
+type LatencyRecorderMock struct {
+	RecordLatencyCalled func(method string, code codes.Code, duration time.Duration)
+}
+
+// RecordLatency mocks the RecordLatency method
+func (mock *LatencyRecorderMock) RecordLatency(method string, code codes.Code, duration time.Duration) {
+	if mock.RecordLatencyCalled != nil {
+		mock.RecordLatencyCalled(method, code, duration)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *LatencyRecorderMock) IsInterfaceNil() bool {
+	return mock == nil
+}
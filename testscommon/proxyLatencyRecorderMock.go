@@ -0,0 +1,20 @@
+package testscommon
+
+import "time"
+
+// ProxyLatencyRecorderMock mocks ProxyLatencyRecorder interface
+type ProxyLatencyRecorderMock struct {
+	RecordProxyLatencyCalled func(call string, duration time.Duration)
+}
+
+// RecordProxyLatency mocks the RecordProxyLatency method
+func (mock *ProxyLatencyRecorderMock) RecordProxyLatency(call string, duration time.Duration) {
+	if mock.RecordProxyLatencyCalled != nil {
+		mock.RecordProxyLatencyCalled(call, duration)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *ProxyLatencyRecorderMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -9,9 +9,11 @@ import (
 
 // ProxyMock mocks Proxy interface
 type ProxyMock struct {
-	GetAccountCalled       func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
-	GetNetworkConfigCalled func(ctx context.Context) (*data.NetworkConfig, error)
-	IsInterfaceNilCalled   func() bool
+	GetAccountCalled        func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	GetNetworkConfigCalled  func(ctx context.Context) (*data.NetworkConfig, error)
+	GetNetworkStatusCalled  func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	GetShardOfAddressCalled func(ctx context.Context, bech32Address string) (uint32, error)
+	IsInterfaceNilCalled    func() bool
 }
 
 // GetAccount mocks the GetAccount method
@@ -30,6 +32,22 @@ func (mock *ProxyMock) GetNetworkConfig(ctx context.Context) (*data.NetworkConfi
 	return &data.NetworkConfig{}, nil
 }
 
+// GetNetworkStatus mocks the GetNetworkStatus method
+func (mock *ProxyMock) GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+	if mock.GetNetworkStatusCalled != nil {
+		return mock.GetNetworkStatusCalled(ctx, shardID)
+	}
+	return &data.NetworkStatus{}, nil
+}
+
+// GetShardOfAddress mocks the GetShardOfAddress method
+func (mock *ProxyMock) GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error) {
+	if mock.GetShardOfAddressCalled != nil {
+		return mock.GetShardOfAddressCalled(ctx, bech32Address)
+	}
+	return 0, nil
+}
+
 // IsInterfaceNil -
 func (mock *ProxyMock) IsInterfaceNil() bool {
 	return mock == nil
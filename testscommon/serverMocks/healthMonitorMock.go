@@ -0,0 +1,60 @@
+package serverMocks
+
+import (
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	bridgeHealth "github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/health"
+)
+
+// HealthMonitorMock mocks HealthMonitor interface
+type HealthMonitorMock struct {
+	StartChecksCalled  func()
+	SetPausedCalled    func(paused bool)
+	StatusCalled       func() bridgeHealth.Status
+	HealthServerCalled func() grpc_health_v1.HealthServer
+	CloseCalled        func() error
+}
+
+// StartChecks mocks the StartChecks method
+func (mock *HealthMonitorMock) StartChecks() {
+	if mock.StartChecksCalled != nil {
+		mock.StartChecksCalled()
+	}
+}
+
+// SetPaused mocks the SetPaused method
+func (mock *HealthMonitorMock) SetPaused(paused bool) {
+	if mock.SetPausedCalled != nil {
+		mock.SetPausedCalled(paused)
+	}
+}
+
+// Status mocks the Status method
+func (mock *HealthMonitorMock) Status() bridgeHealth.Status {
+	if mock.StatusCalled != nil {
+		return mock.StatusCalled()
+	}
+	return bridgeHealth.Status{}
+}
+
+// HealthServer mocks the HealthServer method
+func (mock *HealthMonitorMock) HealthServer() grpc_health_v1.HealthServer {
+	if mock.HealthServerCalled != nil {
+		return mock.HealthServerCalled()
+	}
+	return health.NewServer()
+}
+
+// Close mocks the Close method
+func (mock *HealthMonitorMock) Close() error {
+	if mock.CloseCalled != nil {
+		return mock.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *HealthMonitorMock) IsInterfaceNil() bool {
+	return mock == nil
+}
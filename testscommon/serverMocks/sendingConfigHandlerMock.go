@@ -0,0 +1,30 @@
+package serverMocks
+
+import "github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+
+// SendingConfigHandlerMock mocks SendingConfigHandler interface
+type SendingConfigHandlerMock struct {
+	SendingConfigCalled    func() txSender.SendingConfig
+	SetSendingConfigCalled func(cfg txSender.SendingConfig) error
+}
+
+// SendingConfig mocks the SendingConfig method
+func (mock *SendingConfigHandlerMock) SendingConfig() txSender.SendingConfig {
+	if mock.SendingConfigCalled != nil {
+		return mock.SendingConfigCalled()
+	}
+	return txSender.SendingConfig{}
+}
+
+// SetSendingConfig mocks the SetSendingConfig method
+func (mock *SendingConfigHandlerMock) SetSendingConfig(cfg txSender.SendingConfig) error {
+	if mock.SetSendingConfigCalled != nil {
+		return mock.SetSendingConfigCalled(cfg)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *SendingConfigHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
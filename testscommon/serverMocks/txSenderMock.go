@@ -0,0 +1,33 @@
+package serverMocks
+
+import (
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+	"github.com/TerraDharitri/drt-go-chain-sovereign-bridge/testscommon"
+)
+
+// TxSenderMock mocks the pausable TxSender interface of the admin controller
+type TxSenderMock struct {
+	testscommon.TxSenderMock
+	SetPausedCalled func(paused bool)
+	StatsCalled     func() txSender.Stats
+}
+
+// SetPaused mocks the SetPaused method
+func (mock *TxSenderMock) SetPaused(paused bool) {
+	if mock.SetPausedCalled != nil {
+		mock.SetPausedCalled(paused)
+	}
+}
+
+// Stats mocks the Stats method
+func (mock *TxSenderMock) Stats() txSender.Stats {
+	if mock.StatsCalled != nil {
+		return mock.StatsCalled()
+	}
+	return txSender.Stats{}
+}
+
+// IsInterfaceNil -
+func (mock *TxSenderMock) IsInterfaceNil() bool {
+	return mock == nil
+}
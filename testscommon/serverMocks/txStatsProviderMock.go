@@ -0,0 +1,21 @@
+package serverMocks
+
+import "github.com/TerraDharitri/drt-go-chain-sovereign-bridge/server/txSender"
+
+// TxStatsProviderMock mocks TxStatsProvider interface
+type TxStatsProviderMock struct {
+	StatsCalled func() txSender.Stats
+}
+
+// Stats mocks the Stats method
+func (mock *TxStatsProviderMock) Stats() txSender.Stats {
+	if mock.StatsCalled != nil {
+		return mock.StatsCalled()
+	}
+	return txSender.Stats{}
+}
+
+// IsInterfaceNil -
+func (mock *TxStatsProviderMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,34 @@
+package testscommon
+
+// TxSenderMetricsMock mocks the tx sender Metrics interface
+type TxSenderMetricsMock struct {
+	AddSentTxsCalled    func(endpoint string, numTxs int)
+	SetNonceCalled      func(nonce uint64)
+	SetQueueDepthCalled func(depth int)
+}
+
+// AddSentTxs mocks the AddSentTxs method
+func (mock *TxSenderMetricsMock) AddSentTxs(endpoint string, numTxs int) {
+	if mock.AddSentTxsCalled != nil {
+		mock.AddSentTxsCalled(endpoint, numTxs)
+	}
+}
+
+// SetNonce mocks the SetNonce method
+func (mock *TxSenderMetricsMock) SetNonce(nonce uint64) {
+	if mock.SetNonceCalled != nil {
+		mock.SetNonceCalled(nonce)
+	}
+}
+
+// SetQueueDepth mocks the SetQueueDepth method
+func (mock *TxSenderMetricsMock) SetQueueDepth(depth int) {
+	if mock.SetQueueDepthCalled != nil {
+		mock.SetQueueDepthCalled(depth)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *TxSenderMetricsMock) IsInterfaceNil() bool {
+	return mock == nil
+}